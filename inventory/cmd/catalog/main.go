@@ -0,0 +1,268 @@
+// Command catalog imports parts from CSV or JSON Lines files into the inventory service
+// and exports the catalog to such files. The format is detected by the file extension.
+// TLS of the connection is configured by INVENTORY_GRPC_TLS_* environment variables,
+// the same ones the order service uses.
+//
+// Usage:
+//
+//	catalog import -file parts.csv [-mode upsert|create] [-batch 500] [-addr localhost:50052]
+//	catalog export -file parts.jsonl [-batch 500] [-addr localhost:50052]
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/signal"
+
+	"google.golang.org/grpc"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/catalog"
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/converter"
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+	inventory_v1 "github.com/andredubov/rocket-factory/shared/pkg/proto/inventory/v1"
+	"github.com/andredubov/rocket-factory/shared/pkg/tlsconfig"
+)
+
+// importModes maps values of the mode flag to import modes
+var importModes = map[string]inventory_v1.ImportMode{
+	"create": inventory_v1.ImportMode_IMPORT_MODE_CREATE,
+	"upsert": inventory_v1.ImportMode_IMPORT_MODE_UPSERT,
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, "usage: catalog import|export -file <path> [flags]")
+		os.Exit(2)
+	}
+
+	flags := flag.NewFlagSet(os.Args[1], flag.ExitOnError)
+	addr := flags.String("addr", "localhost:50052", "address of the inventory service")
+	path := flags.String("file", "", "catalog file, .csv or .jsonl")
+	batch := flags.Int("batch", 500, "parts per message")
+	mode := flags.String("mode", "upsert", "import mode: create rejects existing parts, upsert replaces them")
+	_ = flags.Parse(os.Args[2:]) // Exits on error
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var err error
+	switch os.Args[1] {
+	case "import":
+		err = runImport(ctx, *addr, *path, *mode, *batch)
+	case "export":
+		err = runExport(ctx, *addr, *path, *batch)
+	default:
+		err = fmt.Errorf("unknown command %q, import or export expected", os.Args[1])
+	}
+	if err != nil {
+		slog.Error("catalog command failed", "command", os.Args[1], "error", err)
+		os.Exit(1)
+	}
+}
+
+// connect opens connection to the inventory service
+func connect(addr string) (inventory_v1.InventoryServiceClient, func() error, error) {
+	tlsCfg, err := tlsconfig.NewClientEnvConfig("INVENTORY")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	creds, _, err := tlsconfig.NewClientCredentials(tlsCfg, addr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return inventory_v1.NewInventoryServiceClient(conn), conn.Close, nil
+}
+
+// runImport streams parts of the file in batches and logs the report
+// Malformed rows are reported without being sent
+func runImport(ctx context.Context, addr, path, modeName string, batchSize int) error {
+	mode, ok := importModes[modeName]
+	if !ok {
+		return fmt.Errorf("unknown mode %q, create or upsert expected", modeName)
+	}
+
+	format, err := catalog.FormatOf(path)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(path) // #nosec G304 -- path is given by the operator
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader, err := catalog.NewReader(format, file)
+	if err != nil {
+		return err
+	}
+
+	client, closeConn, err := connect(addr)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	stream, err := client.ImportParts(ctx)
+	if err != nil {
+		return err
+	}
+
+	var (
+		batch     []*inventory_v1.Part
+		sentRows  []int // File row of every sent part
+		malformed int
+	)
+	send := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		err := stream.Send(&inventory_v1.ImportPartsRequest{Mode: mode, Parts: batch})
+		batch = nil
+		return err
+	}
+
+	for {
+		part, row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var rowErr *catalog.RowError
+		if errors.As(err, &rowErr) {
+			slog.Warn("malformed row skipped", "row", rowErr.Row, "error", rowErr.Err)
+			malformed++
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		batch = append(batch, converter.PartToProto(part))
+		sentRows = append(sentRows, row)
+		if len(batch) >= batchSize {
+			if err := send(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := send(); err != nil {
+		return err
+	}
+
+	report, err := stream.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+
+	for _, result := range report.GetRows() {
+		if result.GetStatus() == inventory_v1.ImportRowStatus_IMPORT_ROW_STATUS_REJECTED {
+			slog.Warn("part rejected",
+				"row", sentRows[result.GetRow()-1],
+				"part_uuid", result.GetPartUuid(),
+				"error", result.GetError(),
+			)
+		}
+	}
+
+	slog.Info("catalog imported",
+		"file", path,
+		"created", report.GetCreated(),
+		"updated", report.GetUpdated(),
+		"rejected", report.GetRejected(),
+		"malformed", malformed,
+	)
+	return nil
+}
+
+// runExport writes the catalog to the file
+// JSON Lines are written as batches arrive, CSV needs all metadata keys for the header first
+func runExport(ctx context.Context, addr, path string, batchSize int) error {
+	format, err := catalog.FormatOf(path)
+	if err != nil {
+		return err
+	}
+
+	client, closeConn, err := connect(addr)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	stream, err := client.ExportParts(ctx, &inventory_v1.ExportPartsRequest{
+		BatchSize: int32(min(batchSize, 1000)), // #nosec G115 -- capped by the server limit
+	})
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(path) // #nosec G304 -- path is given by the operator
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var (
+		writer catalog.Writer
+		parts  []model.Part // Buffered for CSV only
+		schema = make(model.MetadataSchema)
+		count  int
+	)
+	if format == catalog.FormatJSONLines {
+		if writer, err = catalog.NewWriter(format, file, nil); err != nil {
+			return err
+		}
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, pbPart := range resp.GetParts() {
+			part := converter.PartFromProto(pbPart)
+			count++
+			if writer == nil {
+				schema.Add(part.Metadata)
+				parts = append(parts, part)
+				continue
+			}
+			if err := writer.Write(part); err != nil {
+				return err
+			}
+		}
+	}
+
+	if writer == nil {
+		if writer, err = catalog.NewWriter(format, file, schema); err != nil {
+			return err
+		}
+		for _, part := range parts {
+			if err := writer.Write(part); err != nil {
+				return err
+			}
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+
+	slog.Info("catalog exported", "file", path, "parts", count)
+	return file.Close()
+}
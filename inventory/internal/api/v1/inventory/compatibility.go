@@ -0,0 +1,129 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository"
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/converter"
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+	inventory_v1 "github.com/andredubov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+// maxConfigurationSize limits number of parts validated by a single request
+const maxConfigurationSize = 1000
+
+// CreateCompatibilityRule handles requests to add a compatibility rule.
+func (i *InventoryImplementation) CreateCompatibilityRule(ctx context.Context, req *inventory_v1.CreateCompatibilityRuleRequest) (*inventory_v1.CreateCompatibilityRuleResponse, error) {
+	if req.GetRule() == nil {
+		return nil, status.Error(codes.InvalidArgument, "rule is required")
+	}
+
+	rule := converter.CompatibilityRuleFromProto(req.GetRule())
+	if len(rule.ID) == 0 {
+		rule.ID = uuid.NewString()
+	}
+	if err := rule.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := i.compatibilityRepository.AddRule(ctx, rule); err != nil {
+		if errors.Is(err, repository.ErrRuleAlreadyExists) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "compatibility rule created", "rule_id", rule.ID, "kind", rule.Kind)
+
+	return &inventory_v1.CreateCompatibilityRuleResponse{
+		Rule: converter.CompatibilityRuleToProto(rule),
+	}, nil
+}
+
+// ListCompatibilityRules handles requests to retrieve all compatibility rules.
+func (i *InventoryImplementation) ListCompatibilityRules(ctx context.Context, _ *inventory_v1.ListCompatibilityRulesRequest) (*inventory_v1.ListCompatibilityRulesResponse, error) {
+	rules, err := i.compatibilityRepository.GetRuleList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.CompatibilityRulesToResponse(rules), nil
+}
+
+// DeleteCompatibilityRule handles requests to remove a compatibility rule by its ID.
+func (i *InventoryImplementation) DeleteCompatibilityRule(ctx context.Context, req *inventory_v1.DeleteCompatibilityRuleRequest) (*inventory_v1.DeleteCompatibilityRuleResponse, error) {
+	if len(req.GetId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if err := i.compatibilityRepository.DeleteRule(ctx, req.GetId()); err != nil {
+		if errors.Is(err, repository.ErrRuleNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "compatibility rule deleted", "rule_id", req.GetId())
+
+	return &inventory_v1.DeleteCompatibilityRuleResponse{}, nil
+}
+
+// ValidateConfiguration handles requests to check a set of parts against all compatibility rules.
+func (i *InventoryImplementation) ValidateConfiguration(ctx context.Context, req *inventory_v1.ValidateConfigurationRequest) (*inventory_v1.ValidateConfigurationResponse, error) {
+	switch {
+	case len(req.GetPartUuids()) == 0:
+		return nil, status.Error(codes.InvalidArgument, "at least one part is required")
+	case len(req.GetPartUuids()) > maxConfigurationSize:
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d parts can be validated at once", maxConfigurationSize)
+	}
+
+	parts, err := i.configurationParts(ctx, req.GetPartUuids())
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := i.compatibilityRepository.GetRuleList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := model.CheckConfiguration(rules, parts)
+	if len(violations) > 0 {
+		slog.DebugContext(ctx, "configuration violates compatibility rules",
+			"parts", len(parts),
+			"violations", len(violations),
+		)
+	}
+
+	return converter.RuleViolationsToResponse(violations), nil
+}
+
+// configurationParts fetches parts of a configuration in the given order
+// Every part is fetched once, however many times it is listed
+func (i *InventoryImplementation) configurationParts(ctx context.Context, uuids []string) ([]model.Part, error) {
+	fetched := make(map[string]model.Part, len(uuids))
+	parts := make([]model.Part, 0, len(uuids))
+	for _, partUUID := range uuids {
+		part, ok := fetched[partUUID]
+		if !ok {
+			found, err := i.inventoryRepository.GetPart(ctx, partUUID)
+			if err != nil {
+				if errors.Is(err, repository.ErrPartNotFound) {
+					return nil, status.Errorf(codes.NotFound, "part with UUID %s not found", partUUID)
+				}
+				return nil, err
+			}
+			part = *found
+			fetched[partUUID] = part
+		}
+		parts = append(parts, part)
+	}
+
+	return parts, nil
+}
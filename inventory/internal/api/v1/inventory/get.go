@@ -3,11 +3,12 @@ package server
 import (
 	"context"
 	"errors"
-	"log"
+	"log/slog"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/andredubov/rocket-factory/inventory/internal/expression"
 	"github.com/andredubov/rocket-factory/inventory/internal/repository"
 	"github.com/andredubov/rocket-factory/inventory/internal/repository/converter"
 	inventory_v1 "github.com/andredubov/rocket-factory/shared/pkg/proto/inventory/v1"
@@ -21,7 +22,7 @@ func (i *InventoryImplementation) GetPart(ctx context.Context, req *inventory_v1
 	part, err := i.inventoryRepository.GetPart(ctx, uuid)
 	if err != nil {
 		if errors.Is(err, repository.ErrPartNotFound) {
-			log.Printf("part with UUID %s not found", uuid)
+			slog.DebugContext(ctx, "part not found", "part_uuid", uuid)
 			return nil, status.Errorf(codes.NotFound, "part with UUID %s not found", uuid)
 		}
 		return nil, err
@@ -31,21 +32,66 @@ func (i *InventoryImplementation) GetPart(ctx context.Context, req *inventory_v1
 	return converter.PartToResponse(part), nil
 }
 
-// ListParts handles requests to retrieve multiple inventory parts with optional filtering.
+// ListParts handles requests to retrieve a page of inventory parts with optional filtering, ordering and facets.
 func (i *InventoryImplementation) ListParts(ctx context.Context, req *inventory_v1.ListPartsRequest) (*inventory_v1.ListPartsResponse, error) {
-	// Convert gRPC filter to domain filter
+	// Convert gRPC filter and paging parameters to domain ones
 	filter := converter.PartFilterFromListRequest(req)
+	if err := filter.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Compile filter expression once for all parts
+	if len(req.GetFilterExpression()) > 0 {
+		schema, err := i.inventoryRepository.GetMetadataSchema(ctx)
+		if err != nil {
+			return nil, err
+		}
 
-	// Fetch parts from repository using filter
-	parts, err := i.inventoryRepository.GetPartList(ctx, filter)
+		program, err := expression.Compile(req.GetFilterExpression(), schema)
+		if err != nil {
+			if errors.Is(err, expression.ErrInvalidExpression) {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			return nil, err
+		}
+		filter.Expression = program
+	}
+
+	page, err := converter.PartPageRequestFromListRequest(req)
 	if err != nil {
-		if errors.Is(err, repository.ErrPartNotFound) {
-			log.Printf("target parts not found")
-			return nil, status.Errorf(codes.NotFound, "target parts not found")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	facetRequest := converter.FacetRequestFromProto(req.GetFacets())
+	if facetRequest != nil {
+		if err := facetRequest.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	// Fetch page of parts from repository using filter
+	parts, err := i.inventoryRepository.GetPartPage(ctx, filter, page)
+	if err != nil {
+		if errors.Is(err, expression.ErrCostLimitExceeded) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
 		return nil, err
 	}
 
 	// Convert domain models to gRPC response
-	return converter.PartsToResponse(parts), nil
+	response := converter.PartPageToResponse(parts, req, page.Order)
+
+	// Count facets over the whole filtered list if requested
+	if facetRequest != nil {
+		facets, err := i.inventoryRepository.GetPartFacets(ctx, filter, *facetRequest)
+		if err != nil {
+			if errors.Is(err, expression.ErrCostLimitExceeded) {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			return nil, err
+		}
+		response.Facets = converter.FacetsToProto(facets)
+	}
+
+	return response, nil
 }
@@ -2,18 +2,31 @@ package server
 
 import (
 	"github.com/andredubov/rocket-factory/inventory/internal/repository"
+	"github.com/andredubov/rocket-factory/inventory/internal/search"
+	"github.com/andredubov/rocket-factory/inventory/internal/watch"
 	inventory_v1 "github.com/andredubov/rocket-factory/shared/pkg/proto/inventory/v1"
 )
 
 // InventoryImplementation is the gRPC server implementation for the InventoryService.
 type InventoryImplementation struct {
-	inventory_v1.InventoryServiceServer                      // Embedded gRPC service interface
-	inventoryRepository                 repository.Inventory // Repository for data access
+	inventory_v1.InventoryServiceServer                          // Embedded gRPC service interface
+	inventoryRepository                 repository.Inventory     // Repository for data access
+	compatibilityRepository             repository.Compatibility // Compatibility rules of parts
+	searchIndex                         *search.Index            // Full-text index of parts
+	watchHub                            *watch.Hub               // Source of part change events
 }
 
 // NewInventoryImplementation creates a new instance of the gRPC server implementation.
-func NewInventoryImplementation(repository repository.Inventory) *InventoryImplementation {
+func NewInventoryImplementation(
+	repository repository.Inventory,
+	compatibilityRepository repository.Compatibility,
+	searchIndex *search.Index,
+	watchHub *watch.Hub,
+) *InventoryImplementation {
 	return &InventoryImplementation{
-		inventoryRepository: repository,
+		inventoryRepository:     repository,
+		compatibilityRepository: compatibilityRepository,
+		searchIndex:             searchIndex,
+		watchHub:                watchHub,
 	}
 }
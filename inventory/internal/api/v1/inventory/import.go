@@ -0,0 +1,179 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository"
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/converter"
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+	inventory_v1 "github.com/andredubov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+// maxImportBatchSize limits number of parts in a single ImportParts message
+const maxImportBatchSize = 1000
+
+// Batch size limits of ExportParts
+const (
+	defaultExportBatchSize = 500
+	maxExportBatchSize     = 1000
+)
+
+// ImportParts handles streams of part batches, creating or replacing every valid part.
+// Invalid parts are rejected one by one, the rest of the import goes on.
+func (i *InventoryImplementation) ImportParts(stream grpc.ClientStreamingServer[inventory_v1.ImportPartsRequest, inventory_v1.ImportPartsResponse]) error {
+	ctx := stream.Context()
+
+	var mode inventory_v1.ImportMode
+	report := &inventory_v1.ImportPartsResponse{}
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		// Mode is set by the first batch and can't change afterwards
+		switch {
+		case mode == inventory_v1.ImportMode_IMPORT_MODE_UNSPECIFIED:
+			mode = req.GetMode()
+			if mode != inventory_v1.ImportMode_IMPORT_MODE_CREATE && mode != inventory_v1.ImportMode_IMPORT_MODE_UPSERT {
+				return status.Error(codes.InvalidArgument, "mode must be set to CREATE or UPSERT in the first batch")
+			}
+		case req.GetMode() != inventory_v1.ImportMode_IMPORT_MODE_UNSPECIFIED && req.GetMode() != mode:
+			return status.Errorf(codes.InvalidArgument, "mode %s differs from mode %s of the first batch", req.GetMode(), mode)
+		}
+		if len(req.GetParts()) > maxImportBatchSize {
+			return status.Errorf(codes.InvalidArgument, "batch must contain at most %d parts", maxImportBatchSize)
+		}
+
+		for _, pbPart := range req.GetParts() {
+			result, err := i.importPart(ctx, mode, converter.PartFromProto(pbPart))
+			if err != nil {
+				return err
+			}
+			result.Row = int32(len(report.GetRows()) + 1) // #nosec G115 -- bounded by message size limits
+
+			switch result.GetStatus() {
+			case inventory_v1.ImportRowStatus_IMPORT_ROW_STATUS_CREATED:
+				report.Created++
+			case inventory_v1.ImportRowStatus_IMPORT_ROW_STATUS_UPDATED:
+				report.Updated++
+			default:
+				report.Rejected++
+			}
+			report.Rows = append(report.Rows, result)
+		}
+	}
+
+	slog.InfoContext(ctx, "parts imported",
+		"mode", mode.String(),
+		"created", report.GetCreated(),
+		"updated", report.GetUpdated(),
+		"rejected", report.GetRejected(),
+	)
+
+	return stream.SendAndClose(report)
+}
+
+// importPart validates and stores a single part
+// Returns error only if the import can't go on, e.g. the client went away
+func (i *InventoryImplementation) importPart(ctx context.Context, mode inventory_v1.ImportMode, part model.Part) (*inventory_v1.ImportRowResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	result := &inventory_v1.ImportRowResult{
+		PartUuid: part.Uuid,
+		Status:   inventory_v1.ImportRowStatus_IMPORT_ROW_STATUS_REJECTED,
+	}
+	if err := part.Validate(); err != nil {
+		result.Error = err.Error()
+		return result, nil
+	}
+
+	now := time.Now()
+	part.CreatedAt, part.UpdatedAt = now, now
+
+	existing, err := i.inventoryRepository.GetPart(ctx, part.Uuid)
+	switch {
+	case errors.Is(err, repository.ErrPartNotFound):
+		err = i.inventoryRepository.AddPart(ctx, part)
+		result.Status = inventory_v1.ImportRowStatus_IMPORT_ROW_STATUS_CREATED
+	case err != nil:
+		return nil, err
+	case mode == inventory_v1.ImportMode_IMPORT_MODE_CREATE:
+		err = repository.ErrPartWithUUIDExists(part.Uuid)
+	default:
+		part.CreatedAt = existing.CreatedAt
+		err = i.inventoryRepository.UpdatePart(ctx, part)
+		result.Status = inventory_v1.ImportRowStatus_IMPORT_ROW_STATUS_UPDATED
+	}
+
+	// Part may be added or deleted concurrently
+	if errors.Is(err, repository.ErrPartAlreadyExists) || errors.Is(err, repository.ErrPartNotFound) {
+		result.Status = inventory_v1.ImportRowStatus_IMPORT_ROW_STATUS_REJECTED
+		result.Error = err.Error()
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ExportParts streams parts matching the filter in batches ordered by UUID.
+// Parts are read page by page, so the catalog isn't locked for the whole export.
+func (i *InventoryImplementation) ExportParts(req *inventory_v1.ExportPartsRequest, stream grpc.ServerStreamingServer[inventory_v1.ExportPartsResponse]) error {
+	ctx := stream.Context()
+
+	filter := converter.PartFilterFromProto(req.GetFilter())
+	if err := filter.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	size := int(req.GetBatchSize())
+	switch {
+	case size < 0:
+		return status.Error(codes.InvalidArgument, "batch_size must not be negative")
+	case size == 0:
+		size = defaultExportBatchSize
+	case size > maxExportBatchSize:
+		size = maxExportBatchSize
+	}
+
+	page := model.PartPageRequest{Size: size}
+	exported := 0
+	for {
+		result, err := i.inventoryRepository.GetPartPage(ctx, filter, page)
+		if err != nil {
+			return err
+		}
+
+		if len(result.Parts) > 0 {
+			err := stream.Send(&inventory_v1.ExportPartsResponse{
+				Parts: converter.PartsToResponse(result.Parts).GetParts(),
+			})
+			if err != nil {
+				return err
+			}
+			exported += len(result.Parts)
+		}
+
+		if result.Next == nil {
+			slog.InfoContext(ctx, "parts exported", "count", exported)
+			return nil
+		}
+		page.After = result.Next
+	}
+}
@@ -0,0 +1,94 @@
+package server
+
+import (
+	"context"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/converter"
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+	"github.com/andredubov/rocket-factory/inventory/internal/search"
+	inventory_v1 "github.com/andredubov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+// Result limits of SearchParts
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 100
+)
+
+// SearchParts handles full-text search of inventory parts ordered by relevance.
+func (i *InventoryImplementation) SearchParts(ctx context.Context, req *inventory_v1.SearchPartsRequest) (*inventory_v1.SearchPartsResponse, error) {
+	if len(req.GetQuery()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	}
+	if len(req.GetQuery()) > search.MaxQueryLength {
+		return nil, status.Errorf(codes.InvalidArgument, "query must not be longer than %d bytes", search.MaxQueryLength)
+	}
+	if len(search.Tokenize(req.GetQuery())) > search.MaxQueryTokens {
+		return nil, status.Errorf(codes.InvalidArgument, "query must not contain more than %d words", search.MaxQueryTokens)
+	}
+
+	pageSize := int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	case pageSize == 0:
+		pageSize = defaultSearchPageSize
+	case pageSize > maxSearchPageSize:
+		pageSize = maxSearchPageSize
+	}
+
+	filter := converter.PartFilterFromProto(req.GetFilter())
+	if err := filter.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Find relevant parts in the index
+	hits := i.searchIndex.Search(req.GetQuery())
+	if len(hits) == 0 {
+		return &inventory_v1.SearchPartsResponse{}, nil
+	}
+
+	// Restrict filter to the found parts
+	uuids := make([]string, 0, len(hits))
+	for _, hit := range hits {
+		if len(filter.UUIDs) == 0 || slices.Contains(filter.UUIDs, hit.UUID) {
+			uuids = append(uuids, hit.UUID)
+		}
+	}
+	if len(uuids) == 0 {
+		return &inventory_v1.SearchPartsResponse{}, nil
+	}
+	filter.UUIDs = uuids
+
+	// Fetch found parts matching the filter from repository
+	parts, err := i.inventoryRepository.GetPartList(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make(map[string]model.Part, len(parts))
+	for _, part := range parts {
+		found[part.Uuid] = part
+	}
+
+	// Keep order of relevance
+	results := make([]model.Part, 0, min(len(found), pageSize))
+	scores := make([]float64, 0, cap(results))
+	for _, hit := range hits {
+		part, ok := found[hit.UUID]
+		if !ok {
+			continue
+		}
+		if len(results) < pageSize {
+			results = append(results, part)
+			scores = append(scores, hit.Score)
+		}
+	}
+
+	// Convert domain models to gRPC response
+	return converter.SearchResultsToResponse(results, scores, len(found)), nil
+}
@@ -0,0 +1,76 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository"
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/converter"
+	inventory_v1 "github.com/andredubov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+// AdjustStock handles requests to change stock of a part by a signed delta.
+func (i *InventoryImplementation) AdjustStock(ctx context.Context, req *inventory_v1.AdjustStockRequest) (*inventory_v1.AdjustStockResponse, error) {
+	adjustment := converter.StockAdjustmentFromRequest(req)
+
+	// Validate adjustment before touching the ledger
+	switch {
+	case len(adjustment.PartUUID) == 0:
+		return nil, status.Error(codes.InvalidArgument, "part_uuid is required")
+	case adjustment.Delta == 0:
+		return nil, status.Error(codes.InvalidArgument, "delta must not be zero")
+	case !adjustment.Reason.IsValid():
+		return nil, status.Error(codes.InvalidArgument, "reason is required")
+	case !adjustment.Reason.AllowsDelta(adjustment.Delta):
+		return nil, status.Errorf(codes.InvalidArgument,
+			"delta %d doesn't match reason %s: receipts add parts, sales and damage remove them",
+			adjustment.Delta, adjustment.Reason)
+	case len(adjustment.Actor) == 0:
+		return nil, status.Error(codes.InvalidArgument, "actor is required")
+	case len(adjustment.IdempotencyKey) == 0:
+		return nil, status.Error(codes.InvalidArgument, "idempotency_key is required")
+	}
+
+	result, err := i.inventoryRepository.AdjustStock(ctx, adjustment)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrPartNotFound):
+			return nil, status.Errorf(codes.NotFound, "part with UUID %s not found", adjustment.PartUUID)
+		case errors.Is(err, repository.ErrInsufficientStock):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, repository.ErrIdempotencyKeyReused):
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "stock adjusted",
+		"part_uuid", adjustment.PartUUID,
+		"delta", adjustment.Delta,
+		"reason", adjustment.Reason,
+		"actor", adjustment.Actor,
+		"quantity_after", result.Movement.QuantityAfter,
+		"replayed", result.Replayed,
+	)
+
+	return converter.StockAdjustmentResultToResponse(result), nil
+}
+
+// ListStockMovements handles requests to retrieve a page of the stock ledger.
+func (i *InventoryImplementation) ListStockMovements(ctx context.Context, req *inventory_v1.ListStockMovementsRequest) (*inventory_v1.ListStockMovementsResponse, error) {
+	filter, err := converter.StockMovementFilterFromRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	page, err := i.inventoryRepository.ListStockMovements(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.StockMovementPageToResponse(page), nil
+}
@@ -0,0 +1,95 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/converter"
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+	"github.com/andredubov/rocket-factory/inventory/internal/watch"
+	inventory_v1 "github.com/andredubov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+// WatchParts streams part changes matching the filter until the client disconnects
+// or the subscription is ended by the hub.
+func (i *InventoryImplementation) WatchParts(req *inventory_v1.WatchPartsRequest, stream grpc.ServerStreamingServer[inventory_v1.WatchPartsResponse]) error {
+	ctx := stream.Context()
+
+	filter := converter.PartFilterFromProto(req.GetFilter())
+	if err := filter.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sub, err := i.watchHub.Subscribe(req.GetFromRevision())
+	if err != nil {
+		return watchError(err)
+	}
+	defer sub.Close()
+
+	slog.DebugContext(ctx, "watch started", "from_revision", req.GetFromRevision())
+
+	lastRevision := req.GetFromRevision()
+	send := func(event watch.Event) error {
+		lastRevision = event.Revision
+		if !eventMatches(filter, event) {
+			return nil
+		}
+		return stream.Send(converter.EventToResponse(event))
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-sub.Events():
+			if err := send(event); err != nil {
+				return err
+			}
+		case <-sub.Done():
+			// Deliver events buffered before the subscription ended, the hub no longer adds them
+			for len(sub.Events()) > 0 {
+				if err := send(<-sub.Events()); err != nil {
+					return err
+				}
+			}
+
+			slog.InfoContext(ctx, "watch ended", "last_revision", lastRevision, "reason", sub.Err())
+			if errors.Is(sub.Err(), watch.ErrSlowConsumer) {
+				return status.Errorf(codes.ResourceExhausted, "%s, resume from revision %d", sub.Err(), lastRevision)
+			}
+			return watchError(sub.Err())
+		}
+	}
+}
+
+// eventMatches reports whether the new or the previous version of the part matches the filter
+// Parts the filter can't be evaluated on are not delivered
+func eventMatches(filter model.PartFilter, event watch.Event) bool {
+	if matched, err := filter.Matches(event.Part); err == nil && matched {
+		return true
+	}
+	if event.Previous == nil {
+		return false
+	}
+
+	matched, err := filter.Matches(*event.Previous)
+	return err == nil && matched
+}
+
+// watchError maps watch hub errors to gRPC status
+func watchError(err error) error {
+	switch {
+	case errors.Is(err, watch.ErrRevisionCompacted):
+		return status.Errorf(codes.OutOfRange, "%s, reload parts with ListParts", err)
+	case errors.Is(err, watch.ErrFutureRevision):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, watch.ErrClosed):
+		return status.Error(codes.Unavailable, err.Error())
+	default:
+		return err
+	}
+}
@@ -2,16 +2,32 @@ package app
 
 import (
 	"context"
-	"log"
+	"errors"
+	"log/slog"
 	"net"
+	"net/http"
+	"time"
 
-	"github.com/andredubov/golibs/pkg/closer"
 	"github.com/andredubov/golibs/pkg/config"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/andredubov/rocket-factory/shared/pkg/logger"
+	"github.com/andredubov/rocket-factory/shared/pkg/metrics"
 	inventory_v1 "github.com/andredubov/rocket-factory/shared/pkg/proto/inventory/v1"
+	"github.com/andredubov/rocket-factory/shared/pkg/tlsconfig"
+	"github.com/andredubov/rocket-factory/shared/pkg/tracing"
+)
+
+const (
+	// tracingShutdownTimeout limits how long buffered spans may be flushed on shutdown
+	tracingShutdownTimeout = 5 * time.Second
+	// metricsReadHeaderTimeout protects metrics endpoint from slow clients
+	metricsReadHeaderTimeout = 5 * time.Second
+	// metricsShutdownTimeout limits how long an in-flight scrape may take on shutdown
+	metricsShutdownTimeout = 5 * time.Second
 )
 
 // App represents the core application structure
@@ -19,6 +35,8 @@ import (
 type App struct {
 	serviceProvider *serviceProvider
 	grpcServer      *grpc.Server
+	healthServer    *health.Server
+	metricsServer   *http.Server
 }
 
 // NewApp constructs new application instance
@@ -33,15 +51,27 @@ func NewApp(ctx context.Context) (*App, error) {
 }
 
 // Run starts the application:
-// 1. Launches GRPC server
-// 2. Sets up graceful shutdown via closer
+// 1. Starts readiness watcher and metrics endpoint
+// 2. Launches GRPC server
+// 3. On termination signal stops the server gracefully
+// and closes dependencies stage by stage
 func (a *App) Run() error {
-	defer func() {
-		closer.CloseAll()
-		closer.Wait()
+	defer closeAll()
+
+	runWorker("readiness watcher", a.watchReadiness)
+	go a.runMetricsServer()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- a.runGRPCServer()
 	}()
 
-	return a.runGRPCServer()
+	if err := waitForSignal(errCh); err != nil {
+		return err
+	}
+
+	a.stopGRPCServer()
+	return nil
 }
 
 // initDeps initializes application dependencies
@@ -50,6 +80,9 @@ func (a *App) initDeps(ctx context.Context) error {
 	inits := []func(context.Context) error{
 		a.initConfig,          // Load configuration
 		a.initServiceProvider, // Initialize service container
+		a.initLogger,          // Setup structured logger
+		a.initTracing,         // Setup tracer provider
+		a.initMetricsServer,   // Setup metrics endpoint
 		a.initGRPCServer,      // Setup GRPC server
 	}
 
@@ -78,26 +111,105 @@ func (a *App) initServiceProvider(_ context.Context) error {
 	return nil
 }
 
+// initLogger installs structured logger as default one
+func (a *App) initLogger(_ context.Context) error {
+	logger.Init(a.serviceProvider.LoggerConfig())
+	return nil
+}
+
+// initTracing installs global tracer provider
+// Buffered spans are flushed in the last shutdown stage
+func (a *App) initTracing(ctx context.Context) error {
+	shutdown, err := tracing.Init(ctx, a.serviceProvider.TracingConfig())
+	if err != nil {
+		return err
+	}
+
+	addCloser(stageTelemetry, "tracer provider", func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+		defer cancel()
+
+		return shutdown(ctx)
+	})
+
+	return nil
+}
+
+// initMetricsServer configures HTTP server exposing /metrics
+// The server is shut down in the last shutdown stage
+func (a *App) initMetricsServer(ctx context.Context) error {
+	a.serviceProvider.StockCollector(ctx)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+
+	a.metricsServer = &http.Server{
+		Addr:              a.serviceProvider.MetricsConfig().Address(),
+		Handler:           mux,
+		ReadHeaderTimeout: metricsReadHeaderTimeout,
+	}
+
+	addCloser(stageTelemetry, "metrics server", func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), metricsShutdownTimeout)
+		defer cancel()
+
+		return a.metricsServer.Shutdown(ctx)
+	})
+
+	return nil
+}
+
 // initGRPCServer configures GRPC server:
-// 1. Creates server with insecure credentials (dev only)
-// 2. Enables reflection for testing
-// 3. Registers inventory service
+//  1. Creates server with TLS (optionally mTLS) or insecure credentials
+//     Certificates are reloaded from disk while the server is running
+//  2. Logs, traces and records metrics of every incoming RPC
+//  3. Enables reflection for testing
+//  4. Registers standard health service
+//  5. Registers inventory service
 func (a *App) initGRPCServer(ctx context.Context) error {
+	creds, reloader, err := tlsconfig.NewServerCredentials(a.serviceProvider.TLSConfig())
+	if err != nil {
+		return err
+	}
+	if reloader != nil {
+		runWorker("certificate reloader", reloader.Watch)
+	}
+
 	opts := []grpc.ServerOption{
-		grpc.Creds(insecure.NewCredentials()), // Disabled security (development only)
+		grpc.Creds(creds),      // Insecure unless GRPC_TLS_ENABLED is set
+		tracing.ServerOption(), // Continue traces started by callers
+		grpc.ChainUnaryInterceptor(
+			logger.UnaryServerInterceptor(), // Request ID is available to the handlers
+			a.serviceProvider.GRPCServerMetrics().UnaryServerInterceptor(),
+		),
 	}
 
 	a.grpcServer = grpc.NewServer(opts...)
 	reflection.Register(a.grpcServer) // For grpcurl testing
+
+	a.healthServer = health.NewServer()
+	a.setServingStatus(grpc_health_v1.HealthCheckResponse_NOT_SERVING) // Until storage is checked
+	grpc_health_v1.RegisterHealthServer(a.grpcServer, a.healthServer)
+
 	inventory_v1.RegisterInventoryServiceServer(a.grpcServer, a.serviceProvider.ServerImplementation(ctx))
 
 	return nil
 }
 
+// runMetricsServer serves /metrics on configured address
+func (a *App) runMetricsServer() {
+	slog.Info("metrics server starting", "address", a.metricsServer.Addr)
+
+	err := a.metricsServer.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		slog.Error("metrics server failed", "error", err)
+	}
+}
+
 // runGRPCServer starts GRPC server on configured address
 func (a *App) runGRPCServer() error {
 	addr := a.serviceProvider.GRPCConfig().Address()
-	log.Printf("gRPC server starting on %s", addr)
+	slog.Info("gRPC server starting", "address", addr)
 
 	listener, err := net.Listen("tcp", addr)
 	if err != nil {
@@ -0,0 +1,49 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	inventory_v1 "github.com/andredubov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+// readinessCheckInterval defines how often storage availability is re-checked
+const readinessCheckInterval = 5 * time.Second
+
+// watchReadiness periodically checks storage and reports the result through the health service
+// Stops when ctx is cancelled
+func (a *App) watchReadiness(ctx context.Context) {
+	ticker := time.NewTicker(readinessCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		a.checkReadiness(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkReadiness pings inventory repository and updates serving status accordingly
+func (a *App) checkReadiness(ctx context.Context) {
+	servingStatus := grpc_health_v1.HealthCheckResponse_SERVING
+	if err := a.serviceProvider.InventoryRepository(ctx).Ping(ctx); err != nil {
+		slog.WarnContext(ctx, "inventory repository is not ready", "error", err)
+		servingStatus = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+
+	a.setServingStatus(servingStatus)
+}
+
+// setServingStatus reports status for the whole server and for the inventory service
+// Has no effect once health server is shut down
+func (a *App) setServingStatus(servingStatus grpc_health_v1.HealthCheckResponse_ServingStatus) {
+	a.healthServer.SetServingStatus("", servingStatus)
+	a.healthServer.SetServingStatus(inventory_v1.InventoryService_ServiceDesc.ServiceName, servingStatus)
+}
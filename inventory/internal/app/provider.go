@@ -2,22 +2,52 @@ package app
 
 import (
 	"context"
-	"log"
 
 	"github.com/andredubov/golibs/pkg/config"
 	"github.com/andredubov/golibs/pkg/config/env"
+	"github.com/prometheus/client_golang/prometheus"
 
 	server "github.com/andredubov/rocket-factory/inventory/internal/api/v1/inventory"
+	inventoryconfig "github.com/andredubov/rocket-factory/inventory/internal/config"
+	inventoryenv "github.com/andredubov/rocket-factory/inventory/internal/config/env"
+	inventorymetrics "github.com/andredubov/rocket-factory/inventory/internal/metrics"
 	"github.com/andredubov/rocket-factory/inventory/internal/repository"
+	compatibilitymemory "github.com/andredubov/rocket-factory/inventory/internal/repository/compatibility/memory"
+	compatibilitytraced "github.com/andredubov/rocket-factory/inventory/internal/repository/compatibility/traced"
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/part/indexed"
 	"github.com/andredubov/rocket-factory/inventory/internal/repository/part/memory"
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/part/traced"
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/part/watched"
+	"github.com/andredubov/rocket-factory/inventory/internal/search"
+	"github.com/andredubov/rocket-factory/inventory/internal/watch"
+	"github.com/andredubov/rocket-factory/shared/pkg/logger"
+	"github.com/andredubov/rocket-factory/shared/pkg/metrics"
+	"github.com/andredubov/rocket-factory/shared/pkg/tlsconfig"
+	"github.com/andredubov/rocket-factory/shared/pkg/tracing"
 )
 
+// serviceName identifies the service in logs and traces
+const serviceName = "inventory"
+
 // serviceProvider implements the dependency container pattern
 // It provides lazy initialization of application components
 type serviceProvider struct {
-	inventoryRepository  repository.Inventory            // Inventory data access layer
-	grpcConfig           config.GRPCConfig               // GRPC server configuration
-	serverImplementation *server.InventoryImplementation // GRPC service implementation
+	inventoryRepository     repository.Inventory                // Inventory data access layer
+	compatibilityRepository repository.Compatibility            // Compatibility rules of parts
+	searchIndex             *search.Index                       // Full-text index of parts
+	watchHub                *watch.Hub                          // Part change events of WatchParts
+	grpcConfig              config.GRPCConfig                   // GRPC server configuration
+	loggerConfig            logger.Config                       // Log level and format
+	tracingConfig           tracing.Config                      // Span sampling and export settings
+	tlsConfig               tlsconfig.ServerConfig              // Server TLS and client certificate verification
+	shutdownConfig          inventoryconfig.ShutdownConfig      // Graceful stop deadline
+	watchConfig             inventoryconfig.WatchConfig         // Buffering of part change events
+	compatibilityConfig     inventoryconfig.CompatibilityConfig // Source of compatibility rules
+	metricsConfig           metrics.Config                      // Metrics endpoint configuration
+	grpcServerMetrics       *metrics.GRPCServerMetrics          // GRPC RED metrics
+	stockCollector          *inventorymetrics.StockCollector    // Stock levels exported on scrape
+	serverImplementation    *server.InventoryImplementation     // GRPC service implementation
 }
 
 // newServiceProvider creates a new service provider instance
@@ -31,7 +61,7 @@ func (s *serviceProvider) GRPCConfig() config.GRPCConfig {
 	if s.grpcConfig == nil {
 		cfg, err := env.NewGRPCConfig()
 		if err != nil {
-			log.Fatalf("failed to get grpc config: %s", err.Error())
+			logger.Fatal("failed to get grpc config", "error", err)
 		}
 		s.grpcConfig = cfg
 	}
@@ -39,22 +69,187 @@ func (s *serviceProvider) GRPCConfig() config.GRPCConfig {
 	return s.grpcConfig
 }
 
+// TLSConfig loads server TLS settings from environment variables
+func (s *serviceProvider) TLSConfig() tlsconfig.ServerConfig {
+	if s.tlsConfig == nil {
+		cfg, err := tlsconfig.NewServerEnvConfig()
+		if err != nil {
+			logger.Fatal("failed to get tls config", "error", err)
+		}
+		s.tlsConfig = cfg
+	}
+
+	return s.tlsConfig
+}
+
+// ShutdownConfig loads graceful shutdown settings from environment variables
+func (s *serviceProvider) ShutdownConfig() inventoryconfig.ShutdownConfig {
+	if s.shutdownConfig == nil {
+		cfg, err := inventoryenv.NewShutdownConfig()
+		if err != nil {
+			logger.Fatal("failed to get shutdown config", "error", err)
+		}
+		s.shutdownConfig = cfg
+	}
+
+	return s.shutdownConfig
+}
+
+// WatchConfig loads part change event buffering settings from environment variables
+func (s *serviceProvider) WatchConfig() inventoryconfig.WatchConfig {
+	if s.watchConfig == nil {
+		cfg, err := inventoryenv.NewWatchConfig()
+		if err != nil {
+			logger.Fatal("failed to get watch config", "error", err)
+		}
+		s.watchConfig = cfg
+	}
+
+	return s.watchConfig
+}
+
+// CompatibilityConfig loads compatibility rules settings from environment variables
+func (s *serviceProvider) CompatibilityConfig() inventoryconfig.CompatibilityConfig {
+	if s.compatibilityConfig == nil {
+		cfg, err := inventoryenv.NewCompatibilityConfig()
+		if err != nil {
+			logger.Fatal("failed to get compatibility config", "error", err)
+		}
+		s.compatibilityConfig = cfg
+	}
+
+	return s.compatibilityConfig
+}
+
+// LoggerConfig loads logging configuration from environment variables
+func (s *serviceProvider) LoggerConfig() logger.Config {
+	if s.loggerConfig == nil {
+		cfg, err := logger.NewEnvConfig(serviceName)
+		if err != nil {
+			logger.Fatal("failed to get logger config", "error", err)
+		}
+		s.loggerConfig = cfg
+	}
+
+	return s.loggerConfig
+}
+
+// TracingConfig loads tracing configuration from environment variables
+func (s *serviceProvider) TracingConfig() tracing.Config {
+	if s.tracingConfig == nil {
+		cfg, err := tracing.NewEnvConfig(serviceName)
+		if err != nil {
+			logger.Fatal("failed to get tracing config", "error", err)
+		}
+		s.tracingConfig = cfg
+	}
+
+	return s.tracingConfig
+}
+
+// MetricsConfig loads metrics endpoint configuration from environment variables
+func (s *serviceProvider) MetricsConfig() metrics.Config {
+	if s.metricsConfig == nil {
+		cfg, err := metrics.NewEnvConfig()
+		if err != nil {
+			logger.Fatal("failed to get metrics config", "error", err)
+		}
+		s.metricsConfig = cfg
+	}
+
+	return s.metricsConfig
+}
+
+// GRPCServerMetrics creates RED metrics of handled RPCs
+// Metrics are exported from the default prometheus registry
+func (s *serviceProvider) GRPCServerMetrics() *metrics.GRPCServerMetrics {
+	if s.grpcServerMetrics == nil {
+		s.grpcServerMetrics = metrics.NewGRPCServerMetrics(prometheus.DefaultRegisterer)
+	}
+
+	return s.grpcServerMetrics
+}
+
+// StockCollector creates and registers collector of stock levels
+func (s *serviceProvider) StockCollector(ctx context.Context) *inventorymetrics.StockCollector {
+	if s.stockCollector == nil {
+		s.stockCollector = inventorymetrics.NewStockCollector(s.InventoryRepository(ctx))
+		prometheus.MustRegister(s.stockCollector)
+	}
+
+	return s.stockCollector
+}
+
 // InventoryRepository provides access to inventory data
-// Uses in-memory implementation and singleton pattern
+// Uses in-memory implementation kept in sync with the search index,
+// publishing changes to the watch hub, wrapped with tracing and singleton pattern
+// The repository is closed once background workers are stopped
 func (s *serviceProvider) InventoryRepository(ctx context.Context) repository.Inventory {
 	if s.inventoryRepository == nil {
-		s.inventoryRepository = memory.NewInventoryRepository()
+		repo, err := indexed.NewInventoryRepository(ctx, memory.NewInventoryRepository(), s.SearchIndex())
+		if err != nil {
+			logger.Fatal("failed to create inventory repository", "error", err)
+		}
+		s.inventoryRepository = traced.NewInventoryRepository(watched.NewInventoryRepository(repo, s.WatchHub()))
+		addCloser(stageStorage, "inventory repository", s.inventoryRepository.Close)
 	}
 
 	return s.inventoryRepository
 }
 
+// CompatibilityRepository provides access to compatibility rules of parts
+// Rules are seeded from the configured file, no rules are checked without it
+func (s *serviceProvider) CompatibilityRepository(_ context.Context) repository.Compatibility {
+	if s.compatibilityRepository == nil {
+		var rules []model.CompatibilityRule
+		if file := s.CompatibilityConfig().RulesFile(); file != "" {
+			var err error
+			rules, err = compatibilitymemory.LoadCompatibilityRules(file)
+			if err != nil {
+				logger.Fatal("failed to load compatibility rules", "error", err)
+			}
+		}
+
+		repo, err := compatibilitymemory.NewCompatibilityRepository(rules)
+		if err != nil {
+			logger.Fatal("failed to create compatibility repository", "error", err)
+		}
+		s.compatibilityRepository = compatibilitytraced.NewCompatibilityRepository(repo)
+	}
+
+	return s.compatibilityRepository
+}
+
+// SearchIndex creates full-text index of parts
+func (s *serviceProvider) SearchIndex() *search.Index {
+	if s.searchIndex == nil {
+		s.searchIndex = search.NewIndex()
+	}
+
+	return s.searchIndex
+}
+
+// WatchHub creates hub delivering part changes to WatchParts subscribers
+func (s *serviceProvider) WatchHub() *watch.Hub {
+	if s.watchHub == nil {
+		cfg := s.WatchConfig()
+		s.watchHub = watch.NewHub(cfg.HistorySize(), cfg.BufferSize())
+	}
+
+	return s.watchHub
+}
+
 // ServerImplementation creates GRPC service handler
-// Initializes all required dependencies (repository)
+// Initializes all required dependencies (repositories)
 func (s *serviceProvider) ServerImplementation(ctx context.Context) *server.InventoryImplementation {
 	if s.serverImplementation == nil {
 		inventoryRepository := s.InventoryRepository(ctx)
-		s.serverImplementation = server.NewInventoryImplementation(inventoryRepository)
+		s.serverImplementation = server.NewInventoryImplementation(
+			inventoryRepository,
+			s.CompatibilityRepository(ctx),
+			s.SearchIndex(),
+			s.WatchHub(),
+		)
 	}
 
 	return s.serverImplementation
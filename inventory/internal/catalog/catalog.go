@@ -0,0 +1,90 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+)
+
+// Format is a catalog file format
+type Format string
+
+// Supported formats
+const (
+	FormatCSV       Format = "csv"
+	FormatJSONLines Format = "jsonl"
+)
+
+// ErrUnknownFormat means that the catalog file format is not supported
+var ErrUnknownFormat = errors.New("unknown catalog format, csv or jsonl expected")
+
+// FormatOf detects format of the file by its extension
+func FormatOf(path string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return FormatCSV, nil
+	case ".jsonl", ".ndjson":
+		return FormatJSONLines, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnknownFormat, path)
+	}
+}
+
+// RowError is a malformed row of a catalog file
+// Reading may go on with the next row
+type RowError struct {
+	Row int // Number of the row, starting from 1 and not counting the CSV header
+	Err error
+}
+
+func (e *RowError) Error() string {
+	return fmt.Sprintf("row %d: %v", e.Row, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// Reader reads parts from a catalog file
+type Reader interface {
+	// Read returns the next part and its row number, io.EOF after the last one
+	// Malformed rows are reported as *RowError
+	Read() (model.Part, int, error)
+}
+
+// Writer writes parts to a catalog file
+type Writer interface {
+	Write(part model.Part) error
+	// Flush writes buffered data, it must be called after the last part
+	Flush() error
+}
+
+// NewReader creates reader of the format
+// CSV header is read right away
+func NewReader(format Format, r io.Reader) (Reader, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVReader(r)
+	case FormatJSONLines:
+		return newJSONLinesReader(r), nil
+	default:
+		return nil, ErrUnknownFormat
+	}
+}
+
+// NewWriter creates writer of the format
+// CSV has a column per metadata key of the schema, other metadata keys are not written
+func NewWriter(format Format, w io.Writer, schema model.MetadataSchema) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w, schema), nil
+	case FormatJSONLines:
+		return newJSONLinesWriter(w), nil
+	default:
+		return nil, ErrUnknownFormat
+	}
+}
@@ -0,0 +1,347 @@
+package catalog
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+)
+
+// metadataColumnPrefix starts names of metadata columns: metadata.<key> or metadata.<key>:<kind>
+// Values of columns without a kind are parsed as bool, int64 or double if they look like one
+const metadataColumnPrefix = "metadata."
+
+// tagSeparator separates tags within a cell
+const tagSeparator = ";"
+
+// csvColumns are columns of part fields in the order they are written
+var csvColumns = []string{
+	"uuid", "name", "description", "price", "stock_quantity", "category",
+	"length", "width", "height", "weight",
+	"manufacturer_name", "manufacturer_country", "manufacturer_website",
+	"tags", "created_at", "updated_at",
+}
+
+// requiredCSVColumns must be present in the header of a CSV file
+var requiredCSVColumns = []string{"uuid", "name"}
+
+// metadataColumn is a metadata key stored in a CSV column
+type metadataColumn struct {
+	key  string
+	kind model.ValueKind // Mixed if values are parsed by their look
+}
+
+// parseMetadataColumn parses name of a metadata column
+func parseMetadataColumn(name string) (metadataColumn, error) {
+	key, kind, hasKind := strings.Cut(strings.TrimPrefix(name, metadataColumnPrefix), ":")
+	column := metadataColumn{key: key, kind: model.ValueKind(kind)}
+	if !hasKind {
+		column.kind = model.ValueKindMixed
+	}
+
+	switch {
+	case len(key) == 0:
+		return column, fmt.Errorf("metadata column %q has no key", name)
+	case column.kind != model.ValueKindString && column.kind != model.ValueKindInt64 &&
+		column.kind != model.ValueKindDouble && column.kind != model.ValueKindBool && column.kind != model.ValueKindMixed:
+		return column, fmt.Errorf("metadata column %q has unknown kind, string, int64, double or bool expected", name)
+	}
+
+	return column, nil
+}
+
+// name returns name of the column
+func (c metadataColumn) name() string {
+	if c.kind == model.ValueKindMixed {
+		return metadataColumnPrefix + c.key
+	}
+	return metadataColumnPrefix + c.key + ":" + string(c.kind)
+}
+
+// parse parses a cell of the column, empty cell means no value
+func (c metadataColumn) parse(cell string) (model.Value, bool, error) {
+	if len(cell) == 0 {
+		return model.Value{}, false, nil
+	}
+
+	switch c.kind {
+	case model.ValueKindString:
+		return model.Value{StringValue: &cell}, true, nil
+	case model.ValueKindInt64:
+		i, err := strconv.ParseInt(cell, 10, 64)
+		if err != nil {
+			return model.Value{}, false, fmt.Errorf("invalid integer %q", cell)
+		}
+		return model.Value{Int64Value: &i}, true, nil
+	case model.ValueKindDouble:
+		f, err := strconv.ParseFloat(cell, 64)
+		if err != nil {
+			return model.Value{}, false, fmt.Errorf("invalid number %q", cell)
+		}
+		return model.Value{DoubleValue: &f}, true, nil
+	case model.ValueKindBool:
+		b, err := strconv.ParseBool(cell)
+		if err != nil {
+			return model.Value{}, false, fmt.Errorf("invalid bool %q", cell)
+		}
+		return model.Value{BoolValue: &b}, true, nil
+	}
+
+	// Kind is not declared, guess it by the look of the value
+	switch strings.ToLower(cell) {
+	case "true", "false":
+		b := strings.EqualFold(cell, "true")
+		return model.Value{BoolValue: &b}, true, nil
+	}
+	if value, err := parseNumber(cell); err == nil {
+		return value, true, nil
+	}
+	return model.Value{StringValue: &cell}, true, nil
+}
+
+// format formats a metadata value for a cell of the column
+func (c metadataColumn) format(value model.Value) string {
+	switch {
+	case value.StringValue != nil:
+		return *value.StringValue
+	case value.Int64Value != nil:
+		return strconv.FormatInt(*value.Int64Value, 10)
+	case value.DoubleValue != nil:
+		return formatDouble(*value.DoubleValue)
+	case value.BoolValue != nil:
+		return strconv.FormatBool(*value.BoolValue)
+	default:
+		return ""
+	}
+}
+
+// csvReader reads parts from CSV with a header row, columns may go in any order
+type csvReader struct {
+	r        *csv.Reader
+	columns  map[string]int         // Part field column -> index
+	metadata map[int]metadataColumn // Index -> metadata column
+	row      int
+}
+
+func newCSVReader(r io.Reader) (*csvReader, error) {
+	cr := csv.NewReader(r)
+	cr.ReuseRecord = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+
+	reader := &csvReader{
+		r:        cr,
+		columns:  make(map[string]int, len(header)),
+		metadata: make(map[int]metadataColumn),
+	}
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		if strings.HasPrefix(name, metadataColumnPrefix) {
+			column, err := parseMetadataColumn(name)
+			if err != nil {
+				return nil, err
+			}
+			reader.metadata[i] = column
+			continue
+		}
+
+		if !slices.Contains(csvColumns, name) {
+			return nil, fmt.Errorf("unknown CSV column %q", name)
+		}
+		if _, ok := reader.columns[name]; ok {
+			return nil, fmt.Errorf("duplicate CSV column %q", name)
+		}
+		reader.columns[name] = i
+	}
+
+	for _, name := range requiredCSVColumns {
+		if _, ok := reader.columns[name]; !ok {
+			return nil, fmt.Errorf("CSV column %q is required", name)
+		}
+	}
+
+	return reader, nil
+}
+
+func (r *csvReader) Read() (model.Part, int, error) {
+	cells, err := r.r.Read()
+	if errors.Is(err, io.EOF) {
+		return model.Part{}, 0, io.EOF
+	}
+
+	r.row++
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return model.Part{}, r.row, &RowError{Row: r.row, Err: parseErr.Err}
+	}
+	if err != nil {
+		return model.Part{}, 0, err
+	}
+
+	part, err := r.parse(cells)
+	if err != nil {
+		return model.Part{}, r.row, &RowError{Row: r.row, Err: err}
+	}
+	return part, r.row, nil
+}
+
+// parse converts cells of a row to a part
+func (r *csvReader) parse(cells []string) (model.Part, error) {
+	cell := func(name string) string {
+		if i, ok := r.columns[name]; ok {
+			return strings.TrimSpace(cells[i])
+		}
+		return ""
+	}
+
+	rec := record{
+		UUID:        cell("uuid"),
+		Name:        cell("name"),
+		Description: cell("description"),
+		Category:    cell("category"),
+		Manufacturer: manufacturer{
+			Name:    cell("manufacturer_name"),
+			Country: cell("manufacturer_country"),
+			Website: cell("manufacturer_website"),
+		},
+		CreatedAt: cell("created_at"),
+		UpdatedAt: cell("updated_at"),
+	}
+
+	floats := []struct {
+		name string
+		dst  *float64
+	}{
+		{"price", &rec.Price},
+		{"length", &rec.Dimensions.Length},
+		{"width", &rec.Dimensions.Width},
+		{"height", &rec.Dimensions.Height},
+		{"weight", &rec.Dimensions.Weight},
+	}
+	for _, f := range floats {
+		if raw := cell(f.name); len(raw) > 0 {
+			value, err := strconv.ParseFloat(raw, 64)
+			if err != nil {
+				return model.Part{}, fmt.Errorf("invalid %s %q", f.name, raw)
+			}
+			*f.dst = value
+		}
+	}
+
+	if raw := cell("stock_quantity"); len(raw) > 0 {
+		value, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return model.Part{}, fmt.Errorf("invalid stock_quantity %q", raw)
+		}
+		rec.StockQuantity = value
+	}
+
+	for _, tag := range strings.Split(cell("tags"), tagSeparator) {
+		if tag = strings.TrimSpace(tag); len(tag) > 0 {
+			rec.Tags = append(rec.Tags, tag)
+		}
+	}
+
+	part, err := rec.part()
+	if err != nil {
+		return model.Part{}, err
+	}
+
+	for i, column := range r.metadata {
+		value, ok, err := column.parse(cells[i])
+		if err != nil {
+			return model.Part{}, fmt.Errorf("metadata %q: %w", column.key, err)
+		}
+		if !ok {
+			continue
+		}
+		if part.Metadata == nil {
+			part.Metadata = make(map[string]model.Value, len(r.metadata))
+		}
+		part.Metadata[column.key] = value
+	}
+
+	return part, nil
+}
+
+// csvWriter writes parts as CSV with a header row
+type csvWriter struct {
+	w             *csv.Writer
+	metadata      []metadataColumn
+	headerWritten bool
+}
+
+func newCSVWriter(w io.Writer, schema model.MetadataSchema) *csvWriter {
+	metadata := make([]metadataColumn, 0, len(schema))
+	for _, key := range slices.Sorted(maps.Keys(schema)) {
+		metadata = append(metadata, metadataColumn{key: key, kind: schema[key]})
+	}
+
+	return &csvWriter{
+		w:        csv.NewWriter(w),
+		metadata: metadata,
+	}
+}
+
+// writeHeader writes the header once, before the first part
+func (w *csvWriter) writeHeader() error {
+	if w.headerWritten {
+		return nil
+	}
+	w.headerWritten = true
+
+	header := slices.Clone(csvColumns)
+	for _, column := range w.metadata {
+		header = append(header, column.name())
+	}
+	return w.w.Write(header)
+}
+
+func (w *csvWriter) Write(part model.Part) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+
+	rec := newRecord(part)
+	row := []string{
+		rec.UUID,
+		rec.Name,
+		rec.Description,
+		strconv.FormatFloat(rec.Price, 'f', -1, 64),
+		strconv.FormatInt(rec.StockQuantity, 10),
+		rec.Category,
+		strconv.FormatFloat(rec.Dimensions.Length, 'f', -1, 64),
+		strconv.FormatFloat(rec.Dimensions.Width, 'f', -1, 64),
+		strconv.FormatFloat(rec.Dimensions.Height, 'f', -1, 64),
+		strconv.FormatFloat(rec.Dimensions.Weight, 'f', -1, 64),
+		rec.Manufacturer.Name,
+		rec.Manufacturer.Country,
+		rec.Manufacturer.Website,
+		strings.Join(rec.Tags, tagSeparator),
+		rec.CreatedAt,
+		rec.UpdatedAt,
+	}
+	for _, column := range w.metadata {
+		row = append(row, column.format(part.Metadata[column.key]))
+	}
+
+	return w.w.Write(row)
+}
+
+func (w *csvWriter) Flush() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+
+	w.w.Flush()
+	return w.w.Error()
+}
@@ -0,0 +1,81 @@
+package catalog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+)
+
+// jsonLinesReader reads every part from a JSON object on its own line, blank lines are skipped
+type jsonLinesReader struct {
+	r   *bufio.Reader
+	row int
+}
+
+func newJSONLinesReader(r io.Reader) *jsonLinesReader {
+	return &jsonLinesReader{r: bufio.NewReader(r)}
+}
+
+func (r *jsonLinesReader) Read() (model.Part, int, error) {
+	for {
+		line, err := r.r.ReadBytes('\n')
+		if len(line) == 0 && err != nil {
+			return model.Part{}, 0, err // io.EOF after the last line
+		}
+		if err != nil && !errors.Is(err, io.EOF) {
+			return model.Part{}, 0, err
+		}
+
+		r.row++
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+
+		part, err := decodeJSONLine(line)
+		if err != nil {
+			return model.Part{}, r.row, &RowError{Row: r.row, Err: err}
+		}
+		return part, r.row, nil
+	}
+}
+
+// decodeJSONLine decodes a part, unknown fields are rejected to catch typos
+func decodeJSONLine(line []byte) (model.Part, error) {
+	dec := json.NewDecoder(bytes.NewReader(line))
+	dec.UseNumber()
+	dec.DisallowUnknownFields()
+
+	var rec record
+	if err := dec.Decode(&rec); err != nil {
+		return model.Part{}, err
+	}
+	if dec.More() {
+		return model.Part{}, errors.New("unexpected data after the JSON object")
+	}
+
+	return rec.part()
+}
+
+// jsonLinesWriter writes every part as a JSON object on its own line
+type jsonLinesWriter struct {
+	buf *bufio.Writer
+	enc *json.Encoder
+}
+
+func newJSONLinesWriter(w io.Writer) *jsonLinesWriter {
+	buf := bufio.NewWriter(w)
+	return &jsonLinesWriter{buf: buf, enc: json.NewEncoder(buf)}
+}
+
+func (w *jsonLinesWriter) Write(part model.Part) error {
+	return w.enc.Encode(newRecord(part))
+}
+
+func (w *jsonLinesWriter) Flush() error {
+	return w.buf.Flush()
+}
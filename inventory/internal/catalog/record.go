@@ -0,0 +1,192 @@
+package catalog
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+)
+
+// record is a part in a catalog file, fields are shared by both formats
+type record struct {
+	UUID          string         `json:"uuid"`
+	Name          string         `json:"name"`
+	Description   string         `json:"description,omitempty"`
+	Price         float64        `json:"price"`
+	StockQuantity int64          `json:"stock_quantity"`
+	Category      string         `json:"category"`
+	Dimensions    dimensions     `json:"dimensions"`
+	Manufacturer  manufacturer   `json:"manufacturer"`
+	Tags          []string       `json:"tags,omitempty"`
+	Metadata      map[string]any `json:"metadata,omitempty"`
+	CreatedAt     string         `json:"created_at,omitempty"`
+	UpdatedAt     string         `json:"updated_at,omitempty"`
+}
+
+type dimensions struct {
+	Length float64 `json:"length"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+	Weight float64 `json:"weight"`
+}
+
+type manufacturer struct {
+	Name    string `json:"name"`
+	Country string `json:"country"`
+	Website string `json:"website,omitempty"`
+}
+
+// newRecord converts part to a file record
+// Double metadata values always have a fraction or an exponent, so they are read back as doubles
+func newRecord(part model.Part) record {
+	rec := record{
+		UUID:          part.Uuid,
+		Name:          part.Name,
+		Description:   part.Description,
+		Price:         part.Price,
+		StockQuantity: part.StockQuantity,
+		Category:      part.Category.String(),
+		Dimensions: dimensions{
+			Length: part.Dimensions.Length,
+			Width:  part.Dimensions.Width,
+			Height: part.Dimensions.Height,
+			Weight: part.Dimensions.Weight,
+		},
+		Manufacturer: manufacturer{
+			Name:    part.Manufacturer.Name,
+			Country: part.Manufacturer.Country,
+			Website: part.Manufacturer.Website,
+		},
+		Tags:      part.Tags,
+		CreatedAt: formatTime(part.CreatedAt),
+		UpdatedAt: formatTime(part.UpdatedAt),
+	}
+
+	if len(part.Metadata) > 0 {
+		rec.Metadata = make(map[string]any, len(part.Metadata))
+		for key, value := range part.Metadata {
+			if value.DoubleValue != nil {
+				rec.Metadata[key] = json.Number(formatDouble(*value.DoubleValue))
+				continue
+			}
+			rec.Metadata[key] = value.Interface()
+		}
+	}
+
+	return rec
+}
+
+// part converts the record to a part
+// Metadata numbers are int64 unless they have a fraction or an exponent
+func (r record) part() (model.Part, error) {
+	category, ok := model.ParsePartCategory(r.Category)
+	if !ok && len(r.Category) > 0 {
+		return model.Part{}, fmt.Errorf("unknown category %q", r.Category)
+	}
+
+	part := model.Part{
+		Uuid:          r.UUID,
+		Name:          r.Name,
+		Description:   r.Description,
+		Price:         r.Price,
+		StockQuantity: r.StockQuantity,
+		Category:      category,
+		Dimensions: model.Dimensions{
+			Length: r.Dimensions.Length,
+			Width:  r.Dimensions.Width,
+			Height: r.Dimensions.Height,
+			Weight: r.Dimensions.Weight,
+		},
+		Manufacturer: model.Manufacturer{
+			Name:    r.Manufacturer.Name,
+			Country: r.Manufacturer.Country,
+			Website: r.Manufacturer.Website,
+		},
+		Tags: r.Tags,
+	}
+
+	var err error
+	if part.CreatedAt, err = parseTime("created_at", r.CreatedAt); err != nil {
+		return model.Part{}, err
+	}
+	if part.UpdatedAt, err = parseTime("updated_at", r.UpdatedAt); err != nil {
+		return model.Part{}, err
+	}
+
+	if len(r.Metadata) > 0 {
+		part.Metadata = make(map[string]model.Value, len(r.Metadata))
+		for key, raw := range r.Metadata {
+			value, err := metadataValue(raw)
+			if err != nil {
+				return model.Part{}, fmt.Errorf("metadata %q: %w", key, err)
+			}
+			part.Metadata[key] = value
+		}
+	}
+
+	return part, nil
+}
+
+// metadataValue converts a decoded JSON value to a metadata value
+func metadataValue(raw any) (model.Value, error) {
+	switch v := raw.(type) {
+	case string:
+		return model.Value{StringValue: &v}, nil
+	case bool:
+		return model.Value{BoolValue: &v}, nil
+	case json.Number:
+		return parseNumber(string(v))
+	default:
+		return model.Value{}, fmt.Errorf("unsupported value %v, string, number or bool expected", raw)
+	}
+}
+
+// parseNumber parses int64 unless the number has a fraction or an exponent
+func parseNumber(raw string) (model.Value, error) {
+	if !strings.ContainsAny(raw, ".eE") {
+		i, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return model.Value{}, fmt.Errorf("invalid integer %q", raw)
+		}
+		return model.Value{Int64Value: &i}, nil
+	}
+
+	f, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return model.Value{}, fmt.Errorf("invalid number %q", raw)
+	}
+	return model.Value{DoubleValue: &f}, nil
+}
+
+// formatDouble formats the number so that it is parsed back as a double
+func formatDouble(f float64) string {
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".eEIN") { // Not Inf or NaN either
+		s += ".0"
+	}
+	return s
+}
+
+// formatTime formats time in RFC 3339 with UTC offset, zero time as empty string
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// parseTime parses optional RFC 3339 time
+func parseTime(name, raw string) (time.Time, error) {
+	if len(raw) == 0 {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, raw)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s, RFC 3339 expected: %q", name, raw)
+	}
+	return t, nil
+}
@@ -0,0 +1,19 @@
+package config
+
+import "time"
+
+// ShutdownConfig describes how long the server may drain in-flight RPCs on shutdown
+type ShutdownConfig interface {
+	Timeout() time.Duration // Deadline of graceful stop, remaining RPCs are cancelled after it
+}
+
+// WatchConfig describes buffering of part change events
+type WatchConfig interface {
+	HistorySize() int // Latest events retained for subscribers resuming after reconnect
+	BufferSize() int  // Events buffered per subscriber before it is dropped as too slow
+}
+
+// CompatibilityConfig describes where compatibility rules of parts are loaded from
+type CompatibilityConfig interface {
+	RulesFile() string // JSON seed file of rules, no rules are loaded if empty
+}
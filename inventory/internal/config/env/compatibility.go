@@ -0,0 +1,25 @@
+package env
+
+import (
+	"os"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/config"
+)
+
+const compatibilityRulesFileEnvName = "COMPATIBILITY_RULES_FILE"
+
+type compatibilityConfig struct {
+	rulesFile string
+}
+
+// NewCompatibilityConfig returns compatibility rules settings, the seed file is optional
+func NewCompatibilityConfig() (config.CompatibilityConfig, error) {
+	return &compatibilityConfig{
+		rulesFile: os.Getenv(compatibilityRulesFileEnvName),
+	}, nil
+}
+
+// RulesFile returns path to JSON seed file of compatibility rules
+func (cfg *compatibilityConfig) RulesFile() string {
+	return cfg.rulesFile
+}
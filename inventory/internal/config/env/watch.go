@@ -0,0 +1,68 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/config"
+)
+
+const (
+	watchHistorySizeEnvName = "WATCH_HISTORY_SIZE"
+	watchBufferSizeEnvName  = "WATCH_BUFFER_SIZE"
+)
+
+// Defaults used when watch settings are not set
+const (
+	defaultWatchHistorySize = 10000
+	defaultWatchBufferSize  = 256
+)
+
+type watchConfig struct {
+	historySize int
+	bufferSize  int
+}
+
+// NewWatchConfig reads part change event buffering settings from environment variables
+func NewWatchConfig() (config.WatchConfig, error) {
+	historySize, err := readSize(watchHistorySizeEnvName, defaultWatchHistorySize, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	bufferSize, err := readSize(watchBufferSizeEnvName, defaultWatchBufferSize, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &watchConfig{
+		historySize: historySize,
+		bufferSize:  bufferSize,
+	}, nil
+}
+
+// readSize reads an integer setting that must not be less than minValue
+func readSize(name string, defaultValue, minValue int) (int, error) {
+	raw := os.Getenv(name)
+	if len(raw) == 0 {
+		return defaultValue, nil
+	}
+
+	value, err := strconv.Atoi(raw)
+	if err != nil || value < minValue {
+		return 0, fmt.Errorf("invalid %s: %q", name, raw)
+	}
+
+	return value, nil
+}
+
+// HistorySize returns number of latest events retained for resuming subscribers
+func (cfg *watchConfig) HistorySize() int {
+	return cfg.historySize
+}
+
+// BufferSize returns number of events buffered per subscriber
+func (cfg *watchConfig) BufferSize() int {
+	return cfg.bufferSize
+}
@@ -0,0 +1,229 @@
+package expression
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker"
+	"github.com/google/cel-go/common/types"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+)
+
+// Limits bounding cost of a filter expression
+const (
+	maxExpressionLength = 2048   // Code points of the expression source
+	maxRecursionDepth   = 32     // Nesting depth of the expression
+	maxCost             = 100000 // Evaluation cost per part, see CEL cost model
+	maxListSize         = 256    // Size hint of tags and other lists for cost estimation
+	maxStringSize       = 4096   // Size hint of strings for cost estimation
+)
+
+// Error definitions
+var (
+	ErrInvalidExpression = errors.New("invalid filter expression")
+	ErrCostLimitExceeded = errors.New("filter expression cost limit exceeded")
+)
+
+// Part field variables available to expressions
+// Metadata keys are available as variables too, unless they clash with these names
+// or are not valid identifiers, and always as entries of the metadata map
+var fieldVariables = []cel.EnvOption{
+	cel.Variable("uuid", cel.StringType),
+	cel.Variable("name", cel.StringType),
+	cel.Variable("description", cel.StringType),
+	cel.Variable("price", cel.DoubleType),
+	cel.Variable("stock_quantity", cel.IntType),
+	cel.Variable("category", cel.StringType),
+	cel.Variable("dimensions", cel.MapType(cel.StringType, cel.DoubleType)),
+	cel.Variable("manufacturer", cel.MapType(cel.StringType, cel.StringType)),
+	cel.Variable("tags", cel.ListType(cel.StringType)),
+	cel.Variable("metadata", cel.MapType(cel.StringType, cel.DynType)),
+	cel.Variable("created_at", cel.TimestampType),
+	cel.Variable("updated_at", cel.TimestampType),
+}
+
+// reservedNames are names metadata keys can't be exposed as variables with
+var reservedNames = []string{
+	"uuid", "name", "description", "price", "stock_quantity", "category",
+	"dimensions", "manufacturer", "tags", "metadata", "created_at", "updated_at",
+	"true", "false", "null", "in", "as", "break", "const", "continue", "else",
+	"for", "function", "if", "import", "let", "loop", "package", "namespace",
+	"return", "var", "void", "while",
+}
+
+// Program is a compiled and type-checked filter expression
+// It is safe for concurrent use
+type Program struct {
+	program  cel.Program
+	metadata []string // Metadata keys exposed as variables
+}
+
+// Compile parses and type-checks the expression once, so it can be evaluated against many parts
+// Metadata keys of the schema are declared as variables of their kind,
+// keys with values of different kinds are declared as dyn
+// The expression must evaluate to bool and its estimated cost must not exceed the limit
+func Compile(source string, schema model.MetadataSchema) (*Program, error) {
+	options := append([]cel.EnvOption{
+		cel.CrossTypeNumericComparisons(true),
+		cel.ParserExpressionSizeLimit(maxExpressionLength),
+		cel.ParserRecursionLimit(maxRecursionDepth),
+	}, fieldVariables...)
+
+	metadata := make([]string, 0, len(schema))
+	for key, kind := range schema {
+		if !isIdentifier(key) || slices.Contains(reservedNames, key) {
+			continue
+		}
+		options = append(options, cel.Variable(key, celType(kind)))
+		metadata = append(metadata, key)
+	}
+
+	env, err := cel.NewEnv(options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create expression environment: %w", err)
+	}
+
+	ast, issues := env.Compile(source)
+	if issues.Err() != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidExpression, issues.Err())
+	}
+	if !ast.OutputType().IsExactType(types.BoolType) {
+		return nil, fmt.Errorf("%w: expression must be bool, got %s", ErrInvalidExpression, ast.OutputType())
+	}
+
+	// Reject expressions that may be too expensive before evaluating them
+	estimate, err := env.EstimateCost(ast, sizeEstimator{})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidExpression, err)
+	}
+	if estimate.Max > maxCost {
+		return nil, fmt.Errorf("%w: estimated cost %d exceeds limit %d", ErrInvalidExpression, estimate.Max, maxCost)
+	}
+
+	program, err := env.Program(ast, cel.CostLimit(maxCost), cel.EvalOptions(cel.OptOptimize))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidExpression, err)
+	}
+
+	return &Program{program: program, metadata: metadata}, nil
+}
+
+// Matches evaluates the expression against the part
+// Errors of evaluation, e.g. a metadata key missing on the part, mean the part doesn't match,
+// only exceeding the cost limit is reported as an error
+func (p *Program) Matches(part model.Part) (bool, error) {
+	result, details, err := p.program.Eval(p.activation(part))
+	if err != nil {
+		if cost := details.ActualCost(); cost != nil && *cost >= maxCost {
+			return false, ErrCostLimitExceeded
+		}
+		return false, nil
+	}
+
+	matched, ok := result.Value().(bool)
+	return ok && matched, nil
+}
+
+// activation binds part fields and metadata to expression variables
+func (p *Program) activation(part model.Part) map[string]any {
+	metadata := make(map[string]any, len(part.Metadata))
+	for key, value := range part.Metadata {
+		if v := value.Interface(); v != nil {
+			metadata[key] = v
+		}
+	}
+
+	tags := part.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	vars := map[string]any{
+		"uuid":           part.Uuid,
+		"name":           part.Name,
+		"description":    part.Description,
+		"price":          part.Price,
+		"stock_quantity": part.StockQuantity,
+		"category":       part.Category.String(),
+		"dimensions": map[string]float64{
+			"length": part.Dimensions.Length,
+			"width":  part.Dimensions.Width,
+			"height": part.Dimensions.Height,
+			"weight": part.Dimensions.Weight,
+		},
+		"manufacturer": map[string]string{
+			"name":    part.Manufacturer.Name,
+			"country": part.Manufacturer.Country,
+			"website": part.Manufacturer.Website,
+		},
+		"tags":       tags,
+		"metadata":   metadata,
+		"created_at": part.CreatedAt,
+		"updated_at": part.UpdatedAt,
+	}
+
+	// Metadata keys missing on the part stay unbound, so referring to them fails evaluation
+	for _, key := range p.metadata {
+		if value, ok := metadata[key]; ok {
+			vars[key] = value
+		}
+	}
+
+	return vars
+}
+
+// celType returns expression type of metadata values of the kind
+func celType(kind model.ValueKind) *cel.Type {
+	switch kind {
+	case model.ValueKindString:
+		return cel.StringType
+	case model.ValueKindInt64:
+		return cel.IntType
+	case model.ValueKindDouble:
+		return cel.DoubleType
+	case model.ValueKindBool:
+		return cel.BoolType
+	default:
+		return cel.DynType
+	}
+}
+
+// isIdentifier reports whether the key can be used as a variable name
+func isIdentifier(key string) bool {
+	if len(key) == 0 {
+		return false
+	}
+
+	for i, r := range key {
+		isLetter := r == '_' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z')
+		isDigit := '0' <= r && r <= '9'
+		if !isLetter && (i == 0 || !isDigit) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sizeEstimator provides upper bounds of variable sizes for cost estimation
+type sizeEstimator struct{}
+
+// EstimateSize limits sizes of lists, maps and strings of part variables
+func (sizeEstimator) EstimateSize(element checker.AstNode) *checker.SizeEstimate {
+	switch element.Type().Kind() {
+	case types.ListKind, types.MapKind:
+		return &checker.SizeEstimate{Min: 0, Max: maxListSize}
+	case types.StringKind:
+		return &checker.SizeEstimate{Min: 0, Max: maxStringSize}
+	default:
+		return nil
+	}
+}
+
+// EstimateCallCost leaves call costs to the default cost model
+func (sizeEstimator) EstimateCallCost(function, overloadID string, target *checker.AstNode, args []checker.AstNode) *checker.CallEstimate {
+	return nil
+}
@@ -0,0 +1,69 @@
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository"
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+)
+
+// collectTimeout bounds reading of the repository on a single scrape
+const collectTimeout = 2 * time.Second
+
+// StockCollector exports current stock levels read from the repository on every scrape
+type StockCollector struct {
+	repo     repository.Inventory
+	quantity *prometheus.Desc
+	parts    *prometheus.Desc
+}
+
+// NewStockCollector creates collector of stock levels per part category
+func NewStockCollector(repo repository.Inventory) *StockCollector {
+	return &StockCollector{
+		repo: repo,
+		quantity: prometheus.NewDesc(
+			"inventory_stock_quantity",
+			"Total number of items in stock per part category.",
+			[]string{"category"}, nil,
+		),
+		parts: prometheus.NewDesc(
+			"inventory_parts",
+			"Number of distinct parts per part category.",
+			[]string{"category"}, nil,
+		),
+	}
+}
+
+// Describe implements prometheus.Collector
+func (c *StockCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.quantity
+	ch <- c.parts
+}
+
+// Collect implements prometheus.Collector
+func (c *StockCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	parts, err := c.repo.GetPartList(ctx, model.PartFilter{})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to collect stock metrics", "error", err)
+		return
+	}
+
+	quantity := make(map[model.PartCategory]int64)
+	count := make(map[model.PartCategory]int)
+	for _, part := range parts {
+		quantity[part.Category] += part.StockQuantity
+		count[part.Category]++
+	}
+
+	for category, total := range quantity {
+		ch <- prometheus.MustNewConstMetric(c.quantity, prometheus.GaugeValue, float64(total), category.String())
+		ch <- prometheus.MustNewConstMetric(c.parts, prometheus.GaugeValue, float64(count[category]), category.String())
+	}
+}
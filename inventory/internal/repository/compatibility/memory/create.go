@@ -0,0 +1,26 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository"
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+)
+
+// AddRule adds a new compatibility rule to the in-memory repository
+// Thread-safe operation using mutex lock
+// Returns:
+// - nil if rule was added successfully
+// - error if rule with same ID already exists
+func (r *compatibilityRepository) AddRule(ctx context.Context, rule model.CompatibilityRule) error {
+	r.mu.Lock()         // Acquire write lock
+	defer r.mu.Unlock() // Ensure lock is released
+
+	// Check for existing rule with same ID
+	if r.find(rule.ID) >= 0 {
+		return repository.ErrRuleWithIDExists(rule.ID)
+	}
+
+	r.rules = append(r.rules, rule)
+	return nil
+}
@@ -0,0 +1,26 @@
+package memory
+
+import (
+	"context"
+	"slices"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository"
+)
+
+// DeleteRule removes a compatibility rule from the repository by ID
+// Thread-safe operation using mutex lock
+// Returns:
+// - nil if rule was deleted successfully
+// - error if rule with specified ID doesn't exist
+func (r *compatibilityRepository) DeleteRule(ctx context.Context, id string) error {
+	r.mu.Lock()         // Acquire write lock
+	defer r.mu.Unlock() // Ensure lock is released
+
+	i := r.find(id)
+	if i < 0 {
+		return repository.ErrRuleWithIDNotFound(id)
+	}
+
+	r.rules = slices.Delete(r.rules, i, i+1)
+	return nil
+}
@@ -0,0 +1,78 @@
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+)
+
+// ruleRecord is a compatibility rule as it is stored in the seed file
+type ruleRecord struct {
+	ID          string         `json:"id"`
+	Kind        model.RuleKind `json:"kind"`
+	Subject     selectorRecord `json:"subject"`
+	Target      selectorRecord `json:"target"`
+	MinCount    int            `json:"min_count"`
+	Description string         `json:"description"`
+}
+
+// selectorRecord is a part selector as it is stored in the seed file
+// Omitted fields match any part
+type selectorRecord struct {
+	PartUUID string `json:"part_uuid"`
+	Category string `json:"category"`
+	Tag      string `json:"tag"`
+}
+
+// LoadCompatibilityRules reads compatibility rules from a JSON seed file
+// Categories are expected by name, e.g. ENGINE
+func LoadCompatibilityRules(path string) ([]model.CompatibilityRule, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- path comes from service configuration
+	if err != nil {
+		return nil, fmt.Errorf("failed to read compatibility rules file: %w", err)
+	}
+
+	var records []ruleRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("failed to parse compatibility rules file %s: %w", path, err)
+	}
+
+	rules := make([]model.CompatibilityRule, 0, len(records))
+	for _, record := range records {
+		subject, err := record.Subject.selector()
+		if err != nil {
+			return nil, fmt.Errorf("%w %s: subject %w", model.ErrInvalidRule, record.ID, err)
+		}
+		target, err := record.Target.selector()
+		if err != nil {
+			return nil, fmt.Errorf("%w %s: target %w", model.ErrInvalidRule, record.ID, err)
+		}
+
+		rules = append(rules, model.CompatibilityRule{
+			ID:          record.ID,
+			Kind:        record.Kind,
+			Subject:     subject,
+			Target:      target,
+			MinCount:    record.MinCount,
+			Description: record.Description,
+		})
+	}
+
+	return rules, nil
+}
+
+// selector converts the record to a part selector
+func (r selectorRecord) selector() (model.PartSelector, error) {
+	category, ok := model.ParsePartCategory(r.Category)
+	if !ok && len(r.Category) > 0 {
+		return model.PartSelector{}, fmt.Errorf("unknown category %q", r.Category)
+	}
+
+	return model.PartSelector{
+		PartUUID: r.PartUUID,
+		Category: category,
+		Tag:      r.Tag,
+	}, nil
+}
@@ -0,0 +1,20 @@
+package memory
+
+import (
+	"context"
+	"slices"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+)
+
+// GetRuleList retrieves all compatibility rules in order they were added
+// Thread-safe read operation using RWMutex
+// Returns:
+// - Copy of the rules, empty slice if there are none
+// - error is always nil for the in-memory implementation
+func (r *compatibilityRepository) GetRuleList(ctx context.Context) ([]model.CompatibilityRule, error) {
+	r.mu.RLock()         // Acquire read lock
+	defer r.mu.RUnlock() // Ensure lock is released
+
+	return slices.Clone(r.rules), nil
+}
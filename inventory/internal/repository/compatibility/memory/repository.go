@@ -0,0 +1,47 @@
+package memory
+
+import (
+	"sync"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository"
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+)
+
+// compatibilityRepository is an in-memory implementation of the Compatibility repository
+// Rules are kept in order they were added, as violations are reported in that order
+type compatibilityRepository struct {
+	mu    sync.RWMutex              // Read-write mutex to protect concurrent access
+	rules []model.CompatibilityRule // Rules in order they were added
+}
+
+// NewCompatibilityRepository creates a new in-memory compatibility rules repository
+// seeded with the given rules
+// Returns error if a rule is invalid or its ID is duplicated
+func NewCompatibilityRepository(rules []model.CompatibilityRule) (repository.Compatibility, error) {
+	r := &compatibilityRepository{
+		rules: make([]model.CompatibilityRule, 0, len(rules)),
+	}
+
+	for _, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return nil, err
+		}
+		if r.find(rule.ID) >= 0 {
+			return nil, repository.ErrRuleWithIDExists(rule.ID)
+		}
+		r.rules = append(r.rules, rule)
+	}
+
+	return r, nil
+}
+
+// find returns position of the rule with the ID, -1 if there is no such rule
+// Caller must hold the lock
+func (r *compatibilityRepository) find(id string) int {
+	for i, rule := range r.rules {
+		if rule.ID == id {
+			return i
+		}
+	}
+	return -1
+}
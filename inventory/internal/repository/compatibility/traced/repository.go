@@ -0,0 +1,60 @@
+package traced
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository"
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+	"github.com/andredubov/rocket-factory/shared/pkg/tracing"
+)
+
+const tracerName = "github.com/andredubov/rocket-factory/inventory/internal/repository"
+
+// compatibilityRepository wraps another Compatibility implementation and records a span per call
+type compatibilityRepository struct {
+	next   repository.Compatibility
+	tracer trace.Tracer
+}
+
+// NewCompatibilityRepository creates tracing decorator around the given compatibility rules repository
+func NewCompatibilityRepository(next repository.Compatibility) repository.Compatibility {
+	return &compatibilityRepository{
+		next:   next,
+		tracer: tracing.Tracer(tracerName),
+	}
+}
+
+// GetRuleList retrieves all compatibility rules
+func (r *compatibilityRepository) GetRuleList(ctx context.Context) (rules []model.CompatibilityRule, err error) {
+	ctx, span := r.tracer.Start(ctx, "CompatibilityRepository.GetRuleList")
+	defer func() {
+		span.SetAttributes(attribute.Int("rules.count", len(rules)))
+		tracing.End(span, err)
+	}()
+
+	return r.next.GetRuleList(ctx)
+}
+
+// AddRule stores a new compatibility rule
+func (r *compatibilityRepository) AddRule(ctx context.Context, rule model.CompatibilityRule) (err error) {
+	ctx, span := r.tracer.Start(ctx, "CompatibilityRepository.AddRule",
+		trace.WithAttributes(
+			attribute.String("rule.id", rule.ID),
+			attribute.String("rule.kind", string(rule.Kind)),
+		))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.AddRule(ctx, rule)
+}
+
+// DeleteRule removes a compatibility rule by its ID
+func (r *compatibilityRepository) DeleteRule(ctx context.Context, id string) (err error) {
+	ctx, span := r.tracer.Start(ctx, "CompatibilityRepository.DeleteRule",
+		trace.WithAttributes(attribute.String("rule.id", id)))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.DeleteRule(ctx, id)
+}
@@ -0,0 +1,95 @@
+package converter
+
+import (
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+	inventory_v1 "github.com/andredubov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+// ruleKinds maps gRPC rule kinds to domain ones
+var ruleKinds = map[inventory_v1.RuleKind]model.RuleKind{
+	inventory_v1.RuleKind_RULE_KIND_REQUIRES: model.RuleKindRequires,
+	inventory_v1.RuleKind_RULE_KIND_EXCLUDES: model.RuleKindExcludes,
+	inventory_v1.RuleKind_RULE_KIND_AT_LEAST: model.RuleKindAtLeast,
+}
+
+// CompatibilityRuleFromProto converts a gRPC CompatibilityRule to a domain one
+// Unknown kind is converted to an empty one
+func CompatibilityRuleFromProto(rule *inventory_v1.CompatibilityRule) model.CompatibilityRule {
+	return model.CompatibilityRule{
+		ID:          rule.GetId(),
+		Kind:        ruleKinds[rule.GetKind()],
+		Subject:     partSelectorFromProto(rule.GetSubject()),
+		Target:      partSelectorFromProto(rule.GetTarget()),
+		MinCount:    int(rule.GetMinCount()),
+		Description: rule.GetDescription(),
+	}
+}
+
+// CompatibilityRuleToProto converts a domain CompatibilityRule to a gRPC one
+func CompatibilityRuleToProto(rule model.CompatibilityRule) *inventory_v1.CompatibilityRule {
+	return &inventory_v1.CompatibilityRule{
+		Id:          rule.ID,
+		Kind:        ruleKindToProto(rule.Kind),
+		Subject:     partSelectorToProto(rule.Subject),
+		Target:      partSelectorToProto(rule.Target),
+		MinCount:    int32(rule.MinCount), // #nosec G115 -- min count is set from int32
+		Description: rule.Description,
+	}
+}
+
+// CompatibilityRulesToResponse converts domain CompatibilityRules to a gRPC ListCompatibilityRulesResponse
+func CompatibilityRulesToResponse(rules []model.CompatibilityRule) *inventory_v1.ListCompatibilityRulesResponse {
+	pbRules := make([]*inventory_v1.CompatibilityRule, 0, len(rules))
+	for _, rule := range rules {
+		pbRules = append(pbRules, CompatibilityRuleToProto(rule))
+	}
+
+	return &inventory_v1.ListCompatibilityRulesResponse{
+		Rules: pbRules,
+	}
+}
+
+// RuleViolationsToResponse converts domain RuleViolations to a gRPC ValidateConfigurationResponse
+func RuleViolationsToResponse(violations []model.RuleViolation) *inventory_v1.ValidateConfigurationResponse {
+	pbViolations := make([]*inventory_v1.RuleViolation, 0, len(violations))
+	for _, violation := range violations {
+		pbViolations = append(pbViolations, &inventory_v1.RuleViolation{
+			Rule:      CompatibilityRuleToProto(violation.Rule),
+			PartUuids: violation.PartUUIDs,
+			Message:   violation.Message,
+		})
+	}
+
+	return &inventory_v1.ValidateConfigurationResponse{
+		Valid:      len(violations) == 0,
+		Violations: pbViolations,
+	}
+}
+
+// partSelectorFromProto converts a gRPC PartSelector to a domain one, nil selects any part
+func partSelectorFromProto(selector *inventory_v1.PartSelector) model.PartSelector {
+	return model.PartSelector{
+		PartUUID: selector.GetPartUuid(),
+		Category: model.PartCategory(selector.GetCategory()),
+		Tag:      selector.GetTag(),
+	}
+}
+
+// partSelectorToProto converts a domain PartSelector to a gRPC one
+func partSelectorToProto(selector model.PartSelector) *inventory_v1.PartSelector {
+	return &inventory_v1.PartSelector{
+		PartUuid: selector.PartUUID,
+		Category: inventory_v1.Category(selector.Category),
+		Tag:      selector.Tag,
+	}
+}
+
+// ruleKindToProto converts a domain RuleKind to a gRPC one
+func ruleKindToProto(kind model.RuleKind) inventory_v1.RuleKind {
+	for pbKind, k := range ruleKinds {
+		if k == kind {
+			return pbKind
+		}
+	}
+	return inventory_v1.RuleKind_RULE_KIND_UNSPECIFIED
+}
@@ -0,0 +1,89 @@
+package converter
+
+import (
+	"math"
+	"slices"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+	inventory_v1 "github.com/andredubov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+// Limits of country and tag facet values
+const (
+	DefaultFacetLimit = 20
+	MaxFacetLimit     = 100
+)
+
+// DefaultPriceBounds are bounds of price buckets used when none are requested
+var DefaultPriceBounds = []float64{100, 1000, 10000, 100000}
+
+// FacetRequestFromProto converts a gRPC FacetsRequest to a domain FacetRequest
+// Returns nil if facets were not requested
+// Limit defaults to DefaultFacetLimit and is capped at MaxFacetLimit
+func FacetRequestFromProto(r *inventory_v1.FacetsRequest) *model.FacetRequest {
+	if r == nil {
+		return nil
+	}
+
+	limit := int(r.GetLimit())
+	switch {
+	case limit <= 0:
+		limit = DefaultFacetLimit
+	case limit > MaxFacetLimit:
+		limit = MaxFacetLimit
+	}
+
+	bounds := r.GetPriceBounds()
+	if len(bounds) == 0 {
+		bounds = DefaultPriceBounds
+	}
+
+	return &model.FacetRequest{
+		Limit:       limit,
+		PriceBounds: slices.Clone(bounds),
+	}
+}
+
+// FacetsToProto converts domain PartFacets to gRPC Facets
+func FacetsToProto(facets model.PartFacets) *inventory_v1.Facets {
+	categories := make([]*inventory_v1.CategoryFacet, len(facets.Categories))
+	for i, c := range facets.Categories {
+		categories[i] = &inventory_v1.CategoryFacet{
+			Category: inventory_v1.Category(c.Category),
+			Count:    facetCount(c.Count),
+		}
+	}
+
+	buckets := make([]*inventory_v1.PriceBucket, len(facets.PriceBuckets))
+	for i, b := range facets.PriceBuckets {
+		buckets[i] = &inventory_v1.PriceBucket{
+			Min:   b.Min,
+			Max:   b.Max,
+			Count: facetCount(b.Count),
+		}
+	}
+
+	return &inventory_v1.Facets{
+		Categories:            categories,
+		ManufacturerCountries: facetValuesToProto(facets.Countries),
+		Tags:                  facetValuesToProto(facets.Tags),
+		PriceBuckets:          buckets,
+	}
+}
+
+// facetValuesToProto converts counts of string values to gRPC FacetValues
+func facetValuesToProto(values []model.ValueCount) []*inventory_v1.FacetValue {
+	result := make([]*inventory_v1.FacetValue, len(values))
+	for i, v := range values {
+		result[i] = &inventory_v1.FacetValue{
+			Value: v.Value,
+			Count: facetCount(v.Count),
+		}
+	}
+	return result
+}
+
+// facetCount converts a count to int32, capping it at MaxInt32
+func facetCount(count int) int32 {
+	return int32(min(count, math.MaxInt32)) // #nosec G115 -- capped at MaxInt32
+}
@@ -1,7 +1,7 @@
 package converter
 
 import (
-	"google.golang.org/protobuf/types/known/timestamppb"
+	"math"
 
 	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
 	inventory_v1 "github.com/andredubov/rocket-factory/shared/pkg/proto/inventory/v1"
@@ -9,13 +9,18 @@ import (
 
 // PartFilterFromListRequest converts a gRPC ListPartsRequest filter to a domain PartFilter
 func PartFilterFromListRequest(r *inventory_v1.ListPartsRequest) model.PartFilter {
-	// Return empty filter if request or filter is nil
-	if r == nil || r.Filter == nil {
+	return PartFilterFromProto(r.GetFilter())
+}
+
+// PartFilterFromProto converts a gRPC PartsFilter to a domain PartFilter
+func PartFilterFromProto(f *inventory_v1.PartsFilter) model.PartFilter {
+	// Return empty filter if filter is nil
+	if f == nil {
 		return model.PartFilter{}
 	}
 
 	// Convert protobuf categories to domain categories
-	pbCategories := r.GetFilter().GetCategories()
+	pbCategories := f.GetCategories()
 	categories := make([]model.PartCategory, len(pbCategories))
 	for i, cat := range pbCategories {
 		categories[i] = model.PartCategory(*cat.Enum())
@@ -23,11 +28,42 @@ func PartFilterFromListRequest(r *inventory_v1.ListPartsRequest) model.PartFilte
 
 	// Build and return the domain filter with all converted fields
 	return model.PartFilter{
-		UUIDs:                 r.GetFilter().GetUuids(),
-		Names:                 r.GetFilter().GetNames(),
+		UUIDs:                 f.GetUuids(),
+		Names:                 f.GetNames(),
 		Categories:            categories,
-		ManufacturerCountries: r.GetFilter().GetManufacturerCountries(),
-		Tags:                  r.GetFilter().GetTags(),
+		ManufacturerCountries: f.GetManufacturerCountries(),
+		Tags:                  f.GetTags(),
+		Price:                 floatRangeFromProto(f.GetPrice()),
+		StockQuantity:         intRangeFromProto(f.GetStockQuantity()),
+		Length:                floatRangeFromProto(f.GetLength()),
+		Width:                 floatRangeFromProto(f.GetWidth()),
+		Height:                floatRangeFromProto(f.GetHeight()),
+		Weight:                floatRangeFromProto(f.GetWeight()),
+		InStockOnly:           f.GetInStockOnly(),
+	}
+}
+
+// floatRangeFromProto converts a protobuf DoubleRange to a domain FloatRange, nil means no bounds
+func floatRangeFromProto(r *inventory_v1.DoubleRange) model.FloatRange {
+	if r == nil {
+		return model.FloatRange{}
+	}
+
+	return model.FloatRange{
+		Min: r.Min,
+		Max: r.Max,
+	}
+}
+
+// intRangeFromProto converts a protobuf Int64Range to a domain IntRange, nil means no bounds
+func intRangeFromProto(r *inventory_v1.Int64Range) model.IntRange {
+	if r == nil {
+		return model.IntRange{}
+	}
+
+	return model.IntRange{
+		Min: r.Min,
+		Max: r.Max,
 	}
 }
 
@@ -38,31 +74,29 @@ func PartsToResponse(parts []model.Part) *inventory_v1.ListPartsResponse {
 
 	// Convert each domain Part to protobuf Part
 	for i, part := range parts {
-		pbParts[i] = &inventory_v1.Part{
-			Uuid:          part.Uuid,
-			Name:          part.Name,
-			Description:   part.Description,
-			Price:         part.Price,
-			StockQuantity: part.StockQuantity,
-			Category:      inventory_v1.Category(part.Category),
-			Dimensions: &inventory_v1.Dimensions{
-				Length: part.Dimensions.Length,
-				Width:  part.Dimensions.Width,
-				Height: part.Dimensions.Height,
-				Weight: part.Dimensions.Weight,
-			},
-			Manufacturer: &inventory_v1.Manufacturer{
-				Name:    part.Manufacturer.Name,
-				Country: part.Manufacturer.Country,
-				Website: part.Manufacturer.Website,
-			},
-			Tags:      part.Tags,
-			CreatedAt: timestamppb.New(part.CreatedAt), // Convert time.Time to Timestamp
-			UpdatedAt: timestamppb.New(part.UpdatedAt), // Convert time.Time to Timestamp
-		}
+		pbParts[i] = PartToProto(part)
 	}
 
 	return &inventory_v1.ListPartsResponse{
 		Parts: pbParts,
 	}
 }
+
+// SearchResultsToResponse converts found domain Parts with their relevance scores
+// to a gRPC SearchPartsResponse
+func SearchResultsToResponse(parts []model.Part, scores []float64, total int) *inventory_v1.SearchPartsResponse {
+	pbParts := PartsToResponse(parts).GetParts()
+
+	results := make([]*inventory_v1.SearchResult, len(pbParts))
+	for i, part := range pbParts {
+		results[i] = &inventory_v1.SearchResult{
+			Part:  part,
+			Score: scores[i],
+		}
+	}
+
+	return &inventory_v1.SearchPartsResponse{
+		Results:   results,
+		TotalSize: int32(min(total, math.MaxInt32)), // #nosec G115 -- capped at MaxInt32
+	}
+}
@@ -0,0 +1,187 @@
+package converter
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+	inventory_v1 "github.com/andredubov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+// Page size limits of ListParts
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 1000
+)
+
+// Error definitions for invalid ListParts paging parameters
+var (
+	ErrInvalidPageSize  = errors.New("page_size must not be negative")
+	ErrInvalidOrderBy   = errors.New("invalid order_by")
+	ErrInvalidPageToken = errors.New("invalid page_token")
+)
+
+// pageToken is an opaque page token
+// The ordering and a hash of the filter are kept to reject tokens of another query
+type pageToken struct {
+	OrderBy       string    `json:"o"`
+	Filter        string    `json:"f"`
+	UUID          string    `json:"u"`
+	Name          string    `json:"n,omitempty"`
+	Price         float64   `json:"p,omitempty"`
+	StockQuantity int64     `json:"s,omitempty"`
+	CreatedAt     time.Time `json:"c,omitzero"`
+}
+
+// PartPageRequestFromListRequest converts paging parameters of a gRPC ListPartsRequest
+// to a domain PartPageRequest
+// Page size defaults to DefaultPageSize and is capped at MaxPageSize
+func PartPageRequestFromListRequest(r *inventory_v1.ListPartsRequest) (model.PartPageRequest, error) {
+	size := int(r.GetPageSize())
+	switch {
+	case size < 0:
+		return model.PartPageRequest{}, ErrInvalidPageSize
+	case size == 0:
+		size = DefaultPageSize
+	case size > MaxPageSize:
+		size = MaxPageSize
+	}
+
+	order, err := parsePartOrder(r.GetOrderBy())
+	if err != nil {
+		return model.PartPageRequest{}, err
+	}
+
+	page := model.PartPageRequest{
+		Order: order,
+		Size:  size,
+	}
+
+	if len(r.GetPageToken()) > 0 {
+		after, err := decodePageToken(r.GetPageToken(), order, filterKey(r))
+		if err != nil {
+			return model.PartPageRequest{}, err
+		}
+		page.After = &after
+	}
+
+	return page, nil
+}
+
+// PartPageToResponse converts a domain PartPage of the gRPC ListPartsRequest to a gRPC ListPartsResponse
+func PartPageToResponse(page model.PartPage, r *inventory_v1.ListPartsRequest, order model.PartOrder) *inventory_v1.ListPartsResponse {
+	response := PartsToResponse(page.Parts)
+	response.TotalSize = int32(min(page.TotalSize, math.MaxInt32)) // #nosec G115 -- capped at MaxInt32
+	if page.Next != nil {
+		response.NextPageToken = encodePageToken(*page.Next, order, filterKey(r))
+	}
+
+	return response
+}
+
+// parsePartOrder parses order_by in "field [asc|desc]" form
+func parsePartOrder(orderBy string) (model.PartOrder, error) {
+	fields := strings.Fields(strings.ToLower(orderBy))
+
+	var order model.PartOrder
+	switch len(fields) {
+	case 0:
+		return order, nil
+	case 2:
+		switch fields[1] {
+		case "asc":
+		case "desc":
+			order.Descending = true
+		default:
+			return order, fmt.Errorf("%w: unknown direction %q", ErrInvalidOrderBy, fields[1])
+		}
+	case 1:
+	default:
+		return order, fmt.Errorf("%w: expected \"field [asc|desc]\", got %q", ErrInvalidOrderBy, orderBy)
+	}
+
+	order.Field = model.PartSortField(fields[0])
+	if order.Field == model.PartSortFieldUUID || !order.Field.IsValid() {
+		return order, fmt.Errorf("%w: unknown field %q", ErrInvalidOrderBy, fields[0])
+	}
+
+	return order, nil
+}
+
+// orderKey returns canonical representation of the order kept in page tokens
+func orderKey(order model.PartOrder) string {
+	if order.Descending {
+		return string(order.Field) + " desc"
+	}
+	return string(order.Field)
+}
+
+// filterKey returns a hash of the filter and the filter expression of the request kept in page tokens
+func filterKey(r *inventory_v1.ListPartsRequest) string {
+	filter, _ := proto.MarshalOptions{Deterministic: true}.Marshal(r.GetFilter()) // Same filter gives same bytes, never fails
+
+	hash := sha256.New()
+	hash.Write(filter)
+	hash.Write([]byte{0}) // Separates the filter from the expression
+	hash.Write([]byte(r.GetFilterExpression()))
+	return base64.RawURLEncoding.EncodeToString(hash.Sum(nil)[:12])
+}
+
+// encodePageToken encodes cursor of the next page
+func encodePageToken(cursor model.PartCursor, order model.PartOrder, filter string) string {
+	token := pageToken{
+		OrderBy: orderKey(order),
+		Filter:  filter,
+		UUID:    cursor.UUID,
+	}
+
+	// Only the key of the ordering field is needed to continue
+	switch order.Field {
+	case model.PartSortFieldName:
+		token.Name = cursor.Name
+	case model.PartSortFieldPrice:
+		token.Price = cursor.Price
+	case model.PartSortFieldStock:
+		token.StockQuantity = cursor.StockQuantity
+	case model.PartSortFieldCreatedAt:
+		token.CreatedAt = cursor.CreatedAt
+	}
+
+	raw, _ := json.Marshal(token) // Marshaling of plain struct never fails
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decodePageToken decodes cursor of the page and checks that it belongs to the same order and filter
+func decodePageToken(raw string, order model.PartOrder, filter string) (model.PartCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return model.PartCursor{}, ErrInvalidPageToken
+	}
+
+	var token pageToken
+	if err := json.Unmarshal(data, &token); err != nil || len(token.UUID) == 0 {
+		return model.PartCursor{}, ErrInvalidPageToken
+	}
+	if token.OrderBy != orderKey(order) {
+		return model.PartCursor{}, fmt.Errorf("%w: order_by differs from the first page", ErrInvalidPageToken)
+	}
+	if token.Filter != filter {
+		return model.PartCursor{}, fmt.Errorf("%w: filter differs from the first page", ErrInvalidPageToken)
+	}
+
+	return model.PartCursor{
+		UUID:          token.UUID,
+		Name:          token.Name,
+		Price:         token.Price,
+		StockQuantity: token.StockQuantity,
+		CreatedAt:     token.CreatedAt,
+	}, nil
+}
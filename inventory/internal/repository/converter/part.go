@@ -16,9 +16,77 @@ func PartFromRequest(request *inventory_v1.GetPartRequest) model.Part {
 
 // PartToResponse converts a domain Part to a gRPC GetPartResponse
 func PartToResponse(part *model.Part) *inventory_v1.GetPartResponse {
-	// Convert metadata map from domain Value to protobuf Value
-	metadata := make(map[string]*inventory_v1.Value)
-	for k, v := range part.Metadata {
+	return &inventory_v1.GetPartResponse{
+		Part: PartToProto(*part),
+	}
+}
+
+// PartToProto converts a domain Part to a gRPC Part
+func PartToProto(part model.Part) *inventory_v1.Part {
+	return &inventory_v1.Part{
+		Uuid:          part.Uuid,
+		Name:          part.Name,
+		Description:   part.Description,
+		Price:         part.Price,
+		StockQuantity: part.StockQuantity,
+		Dimensions: &inventory_v1.Dimensions{
+			Length: part.Dimensions.Length,
+			Width:  part.Dimensions.Width,
+			Height: part.Dimensions.Height,
+			Weight: part.Dimensions.Weight,
+		},
+		Category: inventory_v1.Category(part.Category),
+		Manufacturer: &inventory_v1.Manufacturer{
+			Name:    part.Manufacturer.Name,
+			Country: part.Manufacturer.Country,
+			Website: part.Manufacturer.Website,
+		},
+		Metadata:  MetadataToProto(part.Metadata),
+		Tags:      part.Tags,
+		CreatedAt: timestamppb.New(part.CreatedAt), // Convert time.Time to Timestamp
+		UpdatedAt: timestamppb.New(part.UpdatedAt), // Convert time.Time to Timestamp
+	}
+}
+
+// PartFromProto converts a gRPC Part to a domain Part
+// Missing timestamps are converted to zero time
+func PartFromProto(part *inventory_v1.Part) model.Part {
+	result := model.Part{
+		Uuid:          part.GetUuid(),
+		Name:          part.GetName(),
+		Description:   part.GetDescription(),
+		Price:         part.GetPrice(),
+		StockQuantity: part.GetStockQuantity(),
+		Category:      model.PartCategory(part.GetCategory()),
+		Dimensions: model.Dimensions{
+			Length: part.GetDimensions().GetLength(),
+			Width:  part.GetDimensions().GetWidth(),
+			Height: part.GetDimensions().GetHeight(),
+			Weight: part.GetDimensions().GetWeight(),
+		},
+		Manufacturer: model.Manufacturer{
+			Name:    part.GetManufacturer().GetName(),
+			Country: part.GetManufacturer().GetCountry(),
+			Website: part.GetManufacturer().GetWebsite(),
+		},
+		Tags:     part.GetTags(),
+		Metadata: MetadataFromProto(part.GetMetadata()),
+	}
+
+	if part.GetCreatedAt() != nil {
+		result.CreatedAt = part.GetCreatedAt().AsTime()
+	}
+	if part.GetUpdatedAt() != nil {
+		result.UpdatedAt = part.GetUpdatedAt().AsTime()
+	}
+
+	return result
+}
+
+// MetadataToProto converts metadata map from domain Value to protobuf Value
+func MetadataToProto(metadata map[string]model.Value) map[string]*inventory_v1.Value {
+	result := make(map[string]*inventory_v1.Value, len(metadata))
+	for k, v := range metadata {
 		value := &inventory_v1.Value{}
 
 		// Handle each possible value type in the oneof
@@ -33,33 +101,37 @@ func PartToResponse(part *model.Part) *inventory_v1.GetPartResponse {
 			value.Kind = &inventory_v1.Value_BoolValue{BoolValue: *v.BoolValue}
 		}
 
-		metadata[k] = value
+		result[k] = value
 	}
 
-	// Build and return the complete response with all converted fields
-	return &inventory_v1.GetPartResponse{
-		Part: &inventory_v1.Part{
-			Uuid:          part.Uuid,
-			Name:          part.Name,
-			Description:   part.Description,
-			Price:         part.Price,
-			StockQuantity: part.StockQuantity,
-			Dimensions: &inventory_v1.Dimensions{
-				Length: part.Dimensions.Length,
-				Width:  part.Dimensions.Width,
-				Height: part.Dimensions.Height,
-				Weight: part.Dimensions.Weight,
-			},
-			Category: inventory_v1.Category(part.Category),
-			Manufacturer: &inventory_v1.Manufacturer{
-				Name:    part.Manufacturer.Name,
-				Country: part.Manufacturer.Country,
-				Website: part.Manufacturer.Website,
-			},
-			Metadata:  metadata,
-			Tags:      part.Tags,
-			CreatedAt: timestamppb.New(part.CreatedAt), // Convert time.Time to Timestamp
-			UpdatedAt: timestamppb.New(part.UpdatedAt), // Convert time.Time to Timestamp
-		},
+	return result
+}
+
+// MetadataFromProto converts metadata map from protobuf Value to domain Value
+// Values with no type set are kept as unset values
+func MetadataFromProto(metadata map[string]*inventory_v1.Value) map[string]model.Value {
+	if len(metadata) == 0 {
+		return nil
 	}
+
+	result := make(map[string]model.Value, len(metadata))
+	for k, v := range metadata {
+		var value model.Value
+
+		// Handle each possible value type in the oneof
+		switch kind := v.GetKind().(type) {
+		case *inventory_v1.Value_StringValue:
+			value.StringValue = &kind.StringValue
+		case *inventory_v1.Value_Int64Value:
+			value.Int64Value = &kind.Int64Value
+		case *inventory_v1.Value_DoubleValue:
+			value.DoubleValue = &kind.DoubleValue
+		case *inventory_v1.Value_BoolValue:
+			value.BoolValue = &kind.BoolValue
+		}
+
+		result[k] = value
+	}
+
+	return result
 }
@@ -0,0 +1,119 @@
+package converter
+
+import (
+	"encoding/base64"
+	"strconv"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+	inventory_v1 "github.com/andredubov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+// Page size limits of ListStockMovements
+const (
+	DefaultStockMovementPageSize = 100
+	MaxStockMovementPageSize     = 1000
+)
+
+// stockReasons maps gRPC stock reasons to domain ones
+var stockReasons = map[inventory_v1.StockReason]model.StockReason{
+	inventory_v1.StockReason_STOCK_REASON_RECEIPT:    model.StockReasonReceipt,
+	inventory_v1.StockReason_STOCK_REASON_SALE:       model.StockReasonSale,
+	inventory_v1.StockReason_STOCK_REASON_DAMAGE:     model.StockReasonDamage,
+	inventory_v1.StockReason_STOCK_REASON_CORRECTION: model.StockReasonCorrection,
+}
+
+// StockAdjustmentFromRequest converts a gRPC AdjustStockRequest to a domain StockAdjustment
+// Unknown reason is converted to an empty one
+func StockAdjustmentFromRequest(r *inventory_v1.AdjustStockRequest) model.StockAdjustment {
+	return model.StockAdjustment{
+		PartUUID:       r.GetPartUuid(),
+		Delta:          r.GetDelta(),
+		Reason:         stockReasons[r.GetReason()],
+		Actor:          r.GetActor(),
+		IdempotencyKey: r.GetIdempotencyKey(),
+	}
+}
+
+// StockAdjustmentResultToResponse converts a domain StockAdjustmentResult to a gRPC AdjustStockResponse
+func StockAdjustmentResultToResponse(result model.StockAdjustmentResult) *inventory_v1.AdjustStockResponse {
+	return &inventory_v1.AdjustStockResponse{
+		Movement: StockMovementToProto(result.Movement),
+		Replayed: result.Replayed,
+	}
+}
+
+// StockMovementFilterFromRequest converts a gRPC ListStockMovementsRequest to a domain StockMovementFilter
+// Page size defaults to DefaultStockMovementPageSize and is capped at MaxStockMovementPageSize
+func StockMovementFilterFromRequest(r *inventory_v1.ListStockMovementsRequest) (model.StockMovementFilter, error) {
+	size := int(r.GetPageSize())
+	switch {
+	case size < 0:
+		return model.StockMovementFilter{}, ErrInvalidPageSize
+	case size == 0:
+		size = DefaultStockMovementPageSize
+	case size > MaxStockMovementPageSize:
+		size = MaxStockMovementPageSize
+	}
+
+	filter := model.StockMovementFilter{
+		PartUUID: r.GetPartUuid(),
+		Limit:    size,
+	}
+
+	if len(r.GetPageToken()) > 0 {
+		raw, err := base64.RawURLEncoding.DecodeString(r.GetPageToken())
+		if err != nil {
+			return model.StockMovementFilter{}, ErrInvalidPageToken
+		}
+		filter.AfterSequence, err = strconv.ParseUint(string(raw), 10, 64)
+		if err != nil {
+			return model.StockMovementFilter{}, ErrInvalidPageToken
+		}
+	}
+
+	return filter, nil
+}
+
+// StockMovementPageToResponse converts a domain StockMovementPage to a gRPC ListStockMovementsResponse
+func StockMovementPageToResponse(page model.StockMovementPage) *inventory_v1.ListStockMovementsResponse {
+	movements := make([]*inventory_v1.StockMovement, len(page.Movements))
+	for i, movement := range page.Movements {
+		movements[i] = StockMovementToProto(movement)
+	}
+
+	response := &inventory_v1.ListStockMovementsResponse{
+		Movements: movements,
+	}
+	if page.HasMore && len(page.Movements) > 0 {
+		last := page.Movements[len(page.Movements)-1].Sequence
+		response.NextPageToken = base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatUint(last, 10)))
+	}
+
+	return response
+}
+
+// StockMovementToProto converts a domain StockMovement to a gRPC StockMovement
+func StockMovementToProto(movement model.StockMovement) *inventory_v1.StockMovement {
+	return &inventory_v1.StockMovement{
+		Sequence:       movement.Sequence,
+		PartUuid:       movement.Adjustment.PartUUID,
+		Delta:          movement.Adjustment.Delta,
+		Reason:         stockReasonToProto(movement.Adjustment.Reason),
+		Actor:          movement.Adjustment.Actor,
+		IdempotencyKey: movement.Adjustment.IdempotencyKey,
+		QuantityAfter:  movement.QuantityAfter,
+		CreatedAt:      timestamppb.New(movement.CreatedAt),
+	}
+}
+
+// stockReasonToProto converts a domain StockReason to a gRPC one
+func stockReasonToProto(reason model.StockReason) inventory_v1.StockReason {
+	for pbReason, r := range stockReasons {
+		if r == reason {
+			return pbReason
+		}
+	}
+	return inventory_v1.StockReason_STOCK_REASON_UNSPECIFIED
+}
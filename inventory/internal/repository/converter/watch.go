@@ -0,0 +1,22 @@
+package converter
+
+import (
+	"github.com/andredubov/rocket-factory/inventory/internal/watch"
+	inventory_v1 "github.com/andredubov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+// eventTypes maps domain event types to gRPC ones
+var eventTypes = map[watch.EventType]inventory_v1.PartEventType{
+	watch.EventTypeCreated: inventory_v1.PartEventType_PART_EVENT_TYPE_CREATED,
+	watch.EventTypeUpdated: inventory_v1.PartEventType_PART_EVENT_TYPE_UPDATED,
+	watch.EventTypeDeleted: inventory_v1.PartEventType_PART_EVENT_TYPE_DELETED,
+}
+
+// EventToResponse converts a part change event to a gRPC WatchPartsResponse
+func EventToResponse(event watch.Event) *inventory_v1.WatchPartsResponse {
+	return &inventory_v1.WatchPartsResponse{
+		Revision: event.Revision,
+		Type:     eventTypes[event.Type],
+		Part:     PartToResponse(&event.Part).GetPart(),
+	}
+}
@@ -1,5 +1,7 @@
 package model
 
+import "strings"
+
 type PartCategory int32
 
 // Valid PartCategory values
@@ -20,3 +22,29 @@ func (os PartCategory) IsValid() bool {
 		return false
 	}
 }
+
+// String returns name of the category
+func (os PartCategory) String() string {
+	switch os {
+	case PartCategoryEngine:
+		return "ENGINE"
+	case PartCategoryFuel:
+		return "FUEL"
+	case PartCategoryPorthole:
+		return "PORTHOLE"
+	case PartCategoryWing:
+		return "WING"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// ParsePartCategory parses category name returned by String, ignoring case
+func ParsePartCategory(name string) (PartCategory, bool) {
+	for _, category := range []PartCategory{PartCategoryUnknown, PartCategoryEngine, PartCategoryFuel, PartCategoryPorthole, PartCategoryWing} {
+		if strings.EqualFold(category.String(), name) {
+			return category, true
+		}
+	}
+	return PartCategoryUnknown, false
+}
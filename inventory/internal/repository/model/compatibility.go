@@ -0,0 +1,228 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidRule means that a compatibility rule can't be stored
+var ErrInvalidRule = errors.New("invalid compatibility rule")
+
+// RuleKind defines how a compatibility rule constrains a configuration
+type RuleKind string
+
+// Valid RuleKind values
+const (
+	RuleKindRequires RuleKind = "REQUIRES" // Every subject part needs another part matching the target
+	RuleKindExcludes RuleKind = "EXCLUDES" // Subject parts can't be combined with other parts matching the target
+	RuleKindAtLeast  RuleKind = "AT_LEAST" // Configuration needs at least MinCount parts matching the target
+)
+
+// IsValid checks if the RuleKind has a valid value
+func (k RuleKind) IsValid() bool {
+	switch k {
+	case RuleKindRequires, RuleKindExcludes, RuleKindAtLeast:
+		return true
+	default:
+		return false
+	}
+}
+
+// PartSelector selects parts a compatibility rule refers to
+// A part matches when it matches all the set fields
+type PartSelector struct {
+	PartUUID string       // Exact part, empty for any part
+	Category PartCategory // Category of parts, unknown for any category
+	Tag      string       // Tag of parts ignoring case, empty for any tags
+}
+
+// IsEmpty checks if the selector has no fields set
+func (s PartSelector) IsEmpty() bool {
+	return len(s.PartUUID) == 0 && s.Category == PartCategoryUnknown && len(s.Tag) == 0
+}
+
+// Matches checks if the part matches all the set fields of the selector
+func (s PartSelector) Matches(part Part) bool {
+	if len(s.PartUUID) > 0 && s.PartUUID != part.Uuid {
+		return false
+	}
+	if s.Category != PartCategoryUnknown && s.Category != part.Category {
+		return false
+	}
+	if len(s.Tag) > 0 && !slices.ContainsFunc(part.Tags, func(tag string) bool { return strings.EqualFold(tag, s.Tag) }) {
+		return false
+	}
+	return true
+}
+
+// String describes the selected parts, e.g. "ENGINE tagged methane"
+func (s PartSelector) String() string {
+	var parts []string
+	if len(s.PartUUID) > 0 {
+		parts = append(parts, "part "+s.PartUUID)
+	}
+	if s.Category != PartCategoryUnknown {
+		parts = append(parts, s.Category.String())
+	}
+	if len(s.Tag) > 0 {
+		parts = append(parts, "tagged "+s.Tag)
+	}
+	if len(parts) == 0 {
+		return "any part"
+	}
+	return strings.Join(parts, " ")
+}
+
+// CompatibilityRule is a constraint between parts of a configuration
+type CompatibilityRule struct {
+	ID          string       // Unique identifier
+	Kind        RuleKind     // How the rule constrains a configuration
+	Subject     PartSelector // Parts the rule applies to, empty for AT_LEAST applies to any configuration
+	Target      PartSelector // Parts required, excluded or counted
+	MinCount    int          // Least number of target parts, for AT_LEAST only
+	Description string       // Explanation shown to customers
+}
+
+// Validate checks that the rule is complete and consistent
+func (r CompatibilityRule) Validate() error {
+	if len(r.ID) == 0 {
+		return fmt.Errorf("%w: id is required", ErrInvalidRule)
+	}
+	if !r.Kind.IsValid() {
+		return fmt.Errorf("%w %s: unknown kind %q", ErrInvalidRule, r.ID, r.Kind)
+	}
+
+	selectors := []struct {
+		name     string
+		selector PartSelector
+	}{
+		{"subject", r.Subject},
+		{"target", r.Target},
+	}
+	for _, s := range selectors {
+		if len(s.selector.PartUUID) > 0 {
+			if _, err := uuid.Parse(s.selector.PartUUID); err != nil {
+				return fmt.Errorf("%w %s: %s part_uuid %q is not a valid UUID", ErrInvalidRule, r.ID, s.name, s.selector.PartUUID)
+			}
+		}
+		if !s.selector.Category.IsValid() {
+			return fmt.Errorf("%w %s: unknown %s category %d", ErrInvalidRule, r.ID, s.name, s.selector.Category)
+		}
+	}
+
+	switch {
+	case r.Target.IsEmpty():
+		return fmt.Errorf("%w %s: target is required", ErrInvalidRule, r.ID)
+	case r.Kind != RuleKindAtLeast && r.Subject.IsEmpty():
+		return fmt.Errorf("%w %s: subject is required for %s", ErrInvalidRule, r.ID, r.Kind)
+	case r.Kind == RuleKindAtLeast && r.MinCount < 1:
+		return fmt.Errorf("%w %s: min_count must be positive for %s", ErrInvalidRule, r.ID, r.Kind)
+	case r.Kind != RuleKindAtLeast && r.MinCount != 0:
+		return fmt.Errorf("%w %s: min_count is only allowed for %s", ErrInvalidRule, r.ID, RuleKindAtLeast)
+	}
+
+	return nil
+}
+
+// RuleViolation is a compatibility rule a configuration breaks
+type RuleViolation struct {
+	Rule      CompatibilityRule // Violated rule
+	PartUUIDs []string          // Parts of the configuration breaking the rule, in configuration order
+	Message   string            // What exactly is wrong
+}
+
+// Check evaluates the rule against a configuration
+// Every element of parts is a unit of the configuration, so a part ordered twice is listed twice
+// Subject and target are always different units: a rule "ENGINE excludes ENGINE" allows a single engine
+// Returns the violation and true if the configuration breaks the rule
+func (r CompatibilityRule) Check(parts []Part) (RuleViolation, bool) {
+	var subjects []int
+	if !r.Subject.IsEmpty() {
+		for i, part := range parts {
+			if r.Subject.Matches(part) {
+				subjects = append(subjects, i)
+			}
+		}
+		if len(subjects) == 0 {
+			return RuleViolation{}, false // Rule doesn't apply
+		}
+	}
+
+	// Units other than the subject unit matching the target
+	targetsOf := func(subject int) []int {
+		var targets []int
+		for i, part := range parts {
+			if i != subject && r.Target.Matches(part) {
+				targets = append(targets, i)
+			}
+		}
+		return targets
+	}
+
+	var (
+		offending []int
+		message   string
+	)
+	switch r.Kind {
+	case RuleKindRequires:
+		for _, subject := range subjects {
+			if len(targetsOf(subject)) == 0 {
+				offending = append(offending, subject)
+			}
+		}
+		message = fmt.Sprintf("%s requires %s", r.Subject, r.Target)
+	case RuleKindExcludes:
+		for _, subject := range subjects {
+			if targets := targetsOf(subject); len(targets) > 0 {
+				offending = append(offending, subject)
+				offending = append(offending, targets...)
+			}
+		}
+		message = fmt.Sprintf("%s can't be combined with %s", r.Subject, r.Target)
+	case RuleKindAtLeast:
+		count := len(targetsOf(-1))
+		if count >= r.MinCount {
+			return RuleViolation{}, false
+		}
+		offending = subjects
+		message = fmt.Sprintf("at least %d of %s required, got %d", r.MinCount, r.Target, count)
+		if !r.Subject.IsEmpty() {
+			message = fmt.Sprintf("%s requires at least %d of %s, got %d", r.Subject, r.MinCount, r.Target, count)
+		}
+	}
+
+	if r.Kind != RuleKindAtLeast && len(offending) == 0 {
+		return RuleViolation{}, false
+	}
+
+	// Offending units in configuration order, every part listed once
+	slices.Sort(offending)
+	uuids := make([]string, 0, len(offending))
+	for _, i := range offending {
+		if !slices.Contains(uuids, parts[i].Uuid) {
+			uuids = append(uuids, parts[i].Uuid)
+		}
+	}
+
+	return RuleViolation{
+		Rule:      r,
+		PartUUIDs: uuids,
+		Message:   message,
+	}, true
+}
+
+// CheckConfiguration evaluates all the rules against a configuration
+// Returns violations in order of the rules, nil if the configuration is valid
+func CheckConfiguration(rules []CompatibilityRule, parts []Part) []RuleViolation {
+	var violations []RuleViolation
+	for _, rule := range rules {
+		if violation, violated := rule.Check(parts); violated {
+			violations = append(violations, violation)
+		}
+	}
+	return violations
+}
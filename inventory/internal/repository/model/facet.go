@@ -0,0 +1,54 @@
+package model
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrInvalidPriceBounds means that price bucket bounds are not finite or not strictly increasing
+var ErrInvalidPriceBounds = errors.New("price bounds must be finite and strictly increasing")
+
+// FacetRequest contains parameters of facet counts
+type FacetRequest struct {
+	Limit       int       // Maximum number of values in country and tag facets
+	PriceBounds []float64 // Ascending bounds of price buckets
+}
+
+// Validate checks that price bounds are finite and strictly increasing
+func (r FacetRequest) Validate() error {
+	for i, bound := range r.PriceBounds {
+		if math.IsNaN(bound) || math.IsInf(bound, 0) || (i > 0 && bound <= r.PriceBounds[i-1]) {
+			return ErrInvalidPriceBounds
+		}
+	}
+	return nil
+}
+
+// PartFacets contains numbers of parts per value of filterable fields
+// Every facet is counted over parts matching the filter without its own criterion
+type PartFacets struct {
+	Categories   []CategoryCount // Ordered by count descending
+	Countries    []ValueCount    // Ordered by count descending
+	Tags         []ValueCount    // Ordered by count descending
+	PriceBuckets []PriceBucket   // Ordered by price ascending
+}
+
+// CategoryCount is a number of parts of the category
+type CategoryCount struct {
+	Category PartCategory
+	Count    int
+}
+
+// ValueCount is a number of parts having the value, compared case-insensitively
+type ValueCount struct {
+	Value string
+	Count int
+}
+
+// PriceBucket is a number of parts with price in [Min, Max)
+// Nil bound doesn't limit the price
+type PriceBucket struct {
+	Min   *float64
+	Max   *float64
+	Count int
+}
@@ -1,7 +1,17 @@
 package model
 
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ErrInvalidRange means that lower bound of a range filter exceeds its upper bound
+var ErrInvalidRange = errors.New("range min is greater than max")
+
 // PartFilter contains criteria for searching inventory parts
-// All fields are optional - empty slices mean no filtering on that field
+// All fields are optional - empty slices and ranges mean no filtering on that field
 // Multiple values within a field are treated as OR conditions
 // Different fields are combined with AND logic
 type PartFilter struct {
@@ -10,4 +20,137 @@ type PartFilter struct {
 	Categories            []PartCategory // Filter by categories
 	ManufacturerCountries []string       // Filter by manufacturer countries
 	Tags                  []string       // Filter by part tags
+	Price                 FloatRange     // Filter by unit price
+	StockQuantity         IntRange       // Filter by current inventory count
+	Length                FloatRange     // Filter by length in cm
+	Width                 FloatRange     // Filter by width in cm
+	Height                FloatRange     // Filter by height in cm
+	Weight                FloatRange     // Filter by weight in kg
+	InStockOnly           bool           // Only parts with positive inventory count
+	Expression            PartPredicate  // Compiled filter expression, nil means no expression
+}
+
+// PartPredicate decides whether a part matches a compiled filter expression
+type PartPredicate interface {
+	Matches(part Part) (bool, error)
+}
+
+// FloatRange is an inclusive range of floating-point values
+// Nil bound doesn't limit the value
+type FloatRange struct {
+	Min *float64
+	Max *float64
+}
+
+// IsEmpty reports whether the range doesn't limit values
+func (r FloatRange) IsEmpty() bool {
+	return r.Min == nil && r.Max == nil
+}
+
+// Contains reports whether the value is within the range
+func (r FloatRange) Contains(value float64) bool {
+	return (r.Min == nil || value >= *r.Min) && (r.Max == nil || value <= *r.Max)
+}
+
+// Validate checks that the lower bound doesn't exceed the upper one
+func (r FloatRange) Validate() error {
+	if r.Min != nil && r.Max != nil && *r.Min > *r.Max {
+		return ErrInvalidRange
+	}
+	return nil
+}
+
+// IntRange is an inclusive range of integer values
+// Nil bound doesn't limit the value
+type IntRange struct {
+	Min *int64
+	Max *int64
+}
+
+// IsEmpty reports whether the range doesn't limit values
+func (r IntRange) IsEmpty() bool {
+	return r.Min == nil && r.Max == nil
+}
+
+// Contains reports whether the value is within the range
+func (r IntRange) Contains(value int64) bool {
+	return (r.Min == nil || value >= *r.Min) && (r.Max == nil || value <= *r.Max)
+}
+
+// Validate checks that the lower bound doesn't exceed the upper one
+func (r IntRange) Validate() error {
+	if r.Min != nil && r.Max != nil && *r.Min > *r.Max {
+		return ErrInvalidRange
+	}
+	return nil
+}
+
+// Validate checks that every range of the filter is valid
+func (f PartFilter) Validate() error {
+	ranges := []struct {
+		name string
+		err  error
+	}{
+		{"price", f.Price.Validate()},
+		{"stock_quantity", f.StockQuantity.Validate()},
+		{"length", f.Length.Validate()},
+		{"width", f.Width.Validate()},
+		{"height", f.Height.Validate()},
+		{"weight", f.Weight.Validate()},
+	}
+	for _, r := range ranges {
+		if r.err != nil {
+			return fmt.Errorf("invalid %s filter: %w", r.name, r.err)
+		}
+	}
+
+	return nil
+}
+
+// MatchesRanges reports whether the part satisfies range and stock criteria of the filter
+func (f PartFilter) MatchesRanges(part Part) bool {
+	if f.InStockOnly && part.StockQuantity <= 0 {
+		return false
+	}
+
+	return f.Price.Contains(part.Price) &&
+		f.StockQuantity.Contains(part.StockQuantity) &&
+		f.Length.Contains(part.Dimensions.Length) &&
+		f.Width.Contains(part.Dimensions.Width) &&
+		f.Height.Contains(part.Dimensions.Height) &&
+		f.Weight.Contains(part.Dimensions.Weight)
+}
+
+// HasRanges reports whether the filter has any range or stock criteria
+func (f PartFilter) HasRanges() bool {
+	return !f.Price.IsEmpty() ||
+		!f.StockQuantity.IsEmpty() ||
+		!f.Length.IsEmpty() ||
+		!f.Width.IsEmpty() ||
+		!f.Height.IsEmpty() ||
+		!f.Weight.IsEmpty() ||
+		f.InStockOnly
+}
+
+// Matches reports whether the part satisfies all criteria of the filter
+// Names, countries and tags are compared case-insensitively
+func (f PartFilter) Matches(part Part) (bool, error) {
+	matched := (len(f.UUIDs) == 0 || slices.Contains(f.UUIDs, part.Uuid)) &&
+		(len(f.Names) == 0 || containsFold(f.Names, part.Name)) &&
+		(len(f.Categories) == 0 || slices.Contains(f.Categories, part.Category)) &&
+		(len(f.ManufacturerCountries) == 0 || containsFold(f.ManufacturerCountries, part.Manufacturer.Country)) &&
+		(len(f.Tags) == 0 || slices.ContainsFunc(part.Tags, func(tag string) bool { return containsFold(f.Tags, tag) })) &&
+		f.MatchesRanges(part)
+	if !matched || f.Expression == nil {
+		return matched, nil
+	}
+
+	return f.Expression.Matches(part)
+}
+
+// containsFold reports whether values contain the value ignoring case
+func containsFold(values []string, value string) bool {
+	return slices.ContainsFunc(values, func(v string) bool {
+		return strings.EqualFold(v, value)
+	})
 }
@@ -0,0 +1,68 @@
+package model
+
+// ValueKind is a type of metadata value
+type ValueKind string
+
+// Valid ValueKind values
+const (
+	ValueKindUnset  ValueKind = ""       // Value has no type set
+	ValueKindString ValueKind = "string" // String value
+	ValueKindInt64  ValueKind = "int64"  // Integer value
+	ValueKindDouble ValueKind = "double" // Floating-point value
+	ValueKindBool   ValueKind = "bool"   // Boolean value
+	ValueKindMixed  ValueKind = "mixed"  // Values of the same key have different types across parts
+)
+
+// MetadataSchema maps metadata keys to the kind of their values across the catalog
+type MetadataSchema map[string]ValueKind
+
+// Add merges kinds of the part metadata into the schema
+// A key with values of different kinds becomes ValueKindMixed
+func (s MetadataSchema) Add(metadata map[string]Value) {
+	for key, value := range metadata {
+		kind := value.Kind()
+		if kind == ValueKindUnset {
+			continue
+		}
+
+		known, ok := s[key]
+		switch {
+		case !ok:
+			s[key] = kind
+		case known != kind:
+			s[key] = ValueKindMixed
+		}
+	}
+}
+
+// Kind returns type of the value
+func (v Value) Kind() ValueKind {
+	switch {
+	case v.StringValue != nil:
+		return ValueKindString
+	case v.Int64Value != nil:
+		return ValueKindInt64
+	case v.DoubleValue != nil:
+		return ValueKindDouble
+	case v.BoolValue != nil:
+		return ValueKindBool
+	default:
+		return ValueKindUnset
+	}
+}
+
+// Interface returns the value as string, int64, float64 or bool, nil if no type is set
+func (v Value) Interface() any {
+	switch {
+	case v.StringValue != nil:
+		return *v.StringValue
+	case v.Int64Value != nil:
+		return *v.Int64Value
+	case v.DoubleValue != nil:
+		return *v.DoubleValue
+	case v.BoolValue != nil:
+		return *v.BoolValue
+	default:
+		return nil
+	}
+}
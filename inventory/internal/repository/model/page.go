@@ -0,0 +1,71 @@
+package model
+
+import (
+	"time"
+)
+
+// PartSortField defines the field parts are ordered by
+type PartSortField string
+
+// Valid PartSortField values
+const (
+	PartSortFieldUUID      PartSortField = ""           // Default order by part UUID only
+	PartSortFieldName      PartSortField = "name"       // Order by part name
+	PartSortFieldPrice     PartSortField = "price"      // Order by unit price
+	PartSortFieldStock     PartSortField = "stock"      // Order by stock quantity
+	PartSortFieldCreatedAt PartSortField = "created_at" // Order by creation time
+)
+
+// IsValid checks if the PartSortField has a valid value
+func (f PartSortField) IsValid() bool {
+	switch f {
+	case PartSortFieldUUID, PartSortFieldName, PartSortFieldPrice, PartSortFieldStock, PartSortFieldCreatedAt:
+		return true
+	default:
+		return false
+	}
+}
+
+// PartOrder describes how parts are ordered
+// Parts with equal field values are always ordered by UUID in the same direction
+type PartOrder struct {
+	Field      PartSortField // Field parts are ordered by
+	Descending bool          // Reverse order
+}
+
+// PartCursor is a position in the ordered list of parts
+// It holds sort keys of the last part of the previous page, so the next page
+// starts right after it even if parts were added or removed in the meantime
+// Only UUID and the key of the ordering field are compared
+type PartCursor struct {
+	UUID          string    // UUID of the last part
+	Name          string    // Name of the last part
+	Price         float64   // Price of the last part
+	StockQuantity int64     // Stock of the last part
+	CreatedAt     time.Time // Creation time of the last part
+}
+
+// CursorOf returns cursor pointing right after the given part
+func CursorOf(part Part) PartCursor {
+	return PartCursor{
+		UUID:          part.Uuid,
+		Name:          part.Name,
+		Price:         part.Price,
+		StockQuantity: part.StockQuantity,
+		CreatedAt:     part.CreatedAt,
+	}
+}
+
+// PartPageRequest contains parameters of a page of the parts list
+type PartPageRequest struct {
+	Order PartOrder   // Order of parts across all pages
+	Size  int         // Maximum number of parts on the page
+	After *PartCursor // Position the page starts after, nil for the first page
+}
+
+// PartPage is a page of the ordered parts list
+type PartPage struct {
+	Parts     []Part      // Parts of the page
+	Next      *PartCursor // Position of the next page, nil on the last page
+	TotalSize int         // Number of parts matching the filter on all pages
+}
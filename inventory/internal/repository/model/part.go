@@ -1,9 +1,17 @@
 package model
 
 import (
+	"errors"
+	"fmt"
+	"math"
 	"time"
+
+	"github.com/google/uuid"
 )
 
+// ErrInvalidPart means that a part can't be stored in the catalog
+var ErrInvalidPart = errors.New("invalid part")
+
 // Value represents a typed value for metadata fields
 // Uses pointers to distinguish between zero values and unset values
 type Value struct {
@@ -29,3 +37,51 @@ type Part struct {
 	CreatedAt     time.Time        // When part was added to system
 	UpdatedAt     time.Time        // When part was last modified
 }
+
+// Validate checks that the part is complete and consistent
+// UUID must be canonical, as orders refer to parts by UUID
+func (p Part) Validate() error {
+	if _, err := uuid.Parse(p.Uuid); err != nil {
+		return fmt.Errorf("%w: uuid %q is not a valid UUID", ErrInvalidPart, p.Uuid)
+	}
+
+	switch {
+	case len(p.Name) == 0:
+		return fmt.Errorf("%w: name is required", ErrInvalidPart)
+	case p.Price < 0 || math.IsNaN(p.Price) || math.IsInf(p.Price, 0):
+		return fmt.Errorf("%w: price must be a non-negative number", ErrInvalidPart)
+	case p.StockQuantity < 0:
+		return fmt.Errorf("%w: stock_quantity must not be negative", ErrInvalidPart)
+	case !p.Category.IsValid():
+		return fmt.Errorf("%w: unknown category %d", ErrInvalidPart, p.Category)
+	}
+
+	dimensions := []struct {
+		name  string
+		value float64
+	}{
+		{"length", p.Dimensions.Length},
+		{"width", p.Dimensions.Width},
+		{"height", p.Dimensions.Height},
+		{"weight", p.Dimensions.Weight},
+	}
+	for _, d := range dimensions {
+		if d.value < 0 || math.IsNaN(d.value) || math.IsInf(d.value, 0) {
+			return fmt.Errorf("%w: %s must be a non-negative number", ErrInvalidPart, d.name)
+		}
+	}
+
+	for key, value := range p.Metadata {
+		if len(key) == 0 {
+			return fmt.Errorf("%w: metadata key must not be empty", ErrInvalidPart)
+		}
+		if value.Kind() == ValueKindUnset {
+			return fmt.Errorf("%w: metadata %q has no value", ErrInvalidPart, key)
+		}
+		if value.DoubleValue != nil && (math.IsNaN(*value.DoubleValue) || math.IsInf(*value.DoubleValue, 0)) {
+			return fmt.Errorf("%w: metadata %q must be a finite number", ErrInvalidPart, key)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package model
+
+import (
+	"time"
+)
+
+// StockReason defines why stock of a part changed
+type StockReason string
+
+// Valid StockReason values
+const (
+	StockReasonReceipt    StockReason = "RECEIPT"    // Parts received from a supplier
+	StockReasonSale       StockReason = "SALE"       // Parts sold to a customer
+	StockReasonDamage     StockReason = "DAMAGE"     // Parts written off as damaged
+	StockReasonCorrection StockReason = "CORRECTION" // Stock corrected after a count or a part update
+)
+
+// IsValid checks if the StockReason has a valid value
+func (r StockReason) IsValid() bool {
+	switch r {
+	case StockReasonReceipt, StockReasonSale, StockReasonDamage, StockReasonCorrection:
+		return true
+	default:
+		return false
+	}
+}
+
+// AllowsDelta checks if stock may change by the delta for the reason
+// Receipts add parts, sales and damage remove them, corrections may do both
+func (r StockReason) AllowsDelta(delta int64) bool {
+	switch r {
+	case StockReasonReceipt:
+		return delta > 0
+	case StockReasonSale, StockReasonDamage:
+		return delta < 0
+	default:
+		return delta != 0
+	}
+}
+
+// StockAdjustment is a request to change stock of a part by a signed delta
+type StockAdjustment struct {
+	PartUUID       string      // Part whose stock changes
+	Delta          int64       // Added to the current stock, negative to remove parts
+	Reason         StockReason // Why stock changes
+	Actor          string      // Who changes stock
+	IdempotencyKey string      // Repeated adjustments with the same key are applied once
+}
+
+// StockMovement is a ledger entry of an applied stock adjustment
+type StockMovement struct {
+	Sequence      uint64          // Position in the ledger, increases with every movement
+	Adjustment    StockAdjustment // Applied adjustment
+	QuantityAfter int64           // Stock of the part after the movement
+	CreatedAt     time.Time       // When the movement was applied
+}
+
+// StockAdjustmentResult is an outcome of a stock adjustment
+type StockAdjustmentResult struct {
+	Movement StockMovement // Ledger entry of the adjustment
+	Replayed bool          // Adjustment with the same idempotency key was applied before
+}
+
+// StockMovementFilter selects a page of ledger entries in sequence order
+type StockMovementFilter struct {
+	PartUUID      string // Movements of the part, empty for all parts
+	AfterSequence uint64 // Movements following the sequence
+	Limit         int    // Maximum number of movements
+}
+
+// StockMovementPage is a page of ledger entries
+type StockMovementPage struct {
+	Movements []StockMovement // Movements in sequence order
+	HasMore   bool            // More movements follow the page
+}
@@ -0,0 +1,77 @@
+package indexed
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository"
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+	"github.com/andredubov/rocket-factory/inventory/internal/search"
+)
+
+// inventoryRepository wraps another Inventory implementation and keeps the search index
+// in sync with it: every successful write is applied to the index incrementally
+// Reads are served by the wrapped repository
+type inventoryRepository struct {
+	repository.Inventory
+	mu    sync.Mutex // Keeps order of index updates the same as order of writes
+	index *search.Index
+}
+
+// NewInventoryRepository creates indexing decorator around the given inventory repository
+// Parts already stored in the repository are indexed right away
+func NewInventoryRepository(ctx context.Context, next repository.Inventory, index *search.Index) (repository.Inventory, error) {
+	parts, err := next.GetPartList(ctx, model.PartFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to index parts: %w", err)
+	}
+
+	for _, part := range parts {
+		index.Add(part)
+	}
+
+	return &inventoryRepository{
+		Inventory: next,
+		index:     index,
+	}, nil
+}
+
+// AddPart stores a new part and indexes it
+func (r *inventoryRepository) AddPart(ctx context.Context, part model.Part) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.Inventory.AddPart(ctx, part); err != nil {
+		return err
+	}
+
+	r.index.Add(part)
+	return nil
+}
+
+// UpdatePart replaces an existing part and reindexes it
+func (r *inventoryRepository) UpdatePart(ctx context.Context, part model.Part) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.Inventory.UpdatePart(ctx, part); err != nil {
+		return err
+	}
+
+	r.index.Add(part)
+	return nil
+}
+
+// DeletePart removes a part and drops it from the index
+func (r *inventoryRepository) DeletePart(ctx context.Context, uuid string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.Inventory.DeletePart(ctx, uuid); err != nil {
+		return err
+	}
+
+	r.index.Remove(uuid)
+	return nil
+}
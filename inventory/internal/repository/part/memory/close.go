@@ -0,0 +1,7 @@
+package memory
+
+// Close releases the repository
+// In-memory storage has nothing to flush, data is lost with the process
+func (i *inventoryRepository) Close() error {
+	return nil
+}
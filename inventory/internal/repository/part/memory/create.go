@@ -2,6 +2,8 @@ package memory
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	"github.com/andredubov/rocket-factory/inventory/internal/repository"
 	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
@@ -9,6 +11,7 @@ import (
 
 // AddPart adds a new part to the in-memory repository
 // Thread-safe operation using mutex lock
+// Initial stock is recorded in the ledger as a receipt
 // Returns:
 // - nil if part was added successfully
 // - error if part with same UUID already exists
@@ -23,6 +26,9 @@ func (p *inventoryRepository) AddPart(ctx context.Context, part model.Part) erro
 
 	// Create defensive copy to prevent external modifications
 	newPart := part
+	newPart.Tags = slices.Clone(part.Tags)
 	p.parts[part.Uuid] = &newPart
+	p.indexes.add(&newPart)
+	p.ledger.recordChange(part.Uuid, 0, part.StockQuantity, model.StockReasonReceipt, time.Now())
 	return nil
 }
@@ -16,10 +16,12 @@ func (p *inventoryRepository) DeletePart(ctx context.Context, uuid string) error
 	defer p.mu.Unlock() // Ensure lock is released
 
 	// Verify part exists before deletion
-	if _, exists := p.parts[uuid]; !exists {
+	part, exists := p.parts[uuid]
+	if !exists {
 		return repository.ErrPartWithUUIDNotFound(uuid)
 	}
 
-	delete(p.parts, uuid) // Remove part from map
+	p.indexes.remove(part) // Drop part from secondary indexes
+	delete(p.parts, uuid)  // Remove part from map
 	return nil
 }
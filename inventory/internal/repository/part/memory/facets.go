@@ -0,0 +1,228 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"math/bits"
+	"slices"
+	"strings"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+)
+
+// facet identifies a filter criterion parts are counted by
+type facet uint
+
+const (
+	facetCategory facet = 1 << iota
+	facetCountry
+	facetTag
+	facetPrice
+)
+
+// GetPartFacets counts parts matching the filter per category, manufacturer country, tag and price bucket
+// Thread-safe read operation using RWMutex
+// Each facet ignores its own criterion of the filter, so counts of a facet show how many parts
+// selecting another value would add. All facets are counted in a single pass:
+// a part missing exactly one facet criterion is counted only in that facet
+// Returns:
+// - Facet counts
+// - error if evaluation of the filter expression failed
+func (i *inventoryRepository) GetPartFacets(ctx context.Context, filter model.PartFilter, request model.FacetRequest) (model.PartFacets, error) {
+	i.mu.RLock()         // Acquire read lock
+	defer i.mu.RUnlock() // Ensure lock is released
+
+	// Criteria shared by all facets
+	base := filter
+	base.Categories = nil
+	base.ManufacturerCountries = nil
+	base.Tags = nil
+	base.Price = model.FloatRange{}
+	base.Expression = nil
+
+	counter := newFacetCounter(request.PriceBounds)
+	count := func(part *model.Part) error {
+		if !base.MatchesRanges(*part) {
+			return nil
+		}
+
+		misses := facetMisses(filter, part)
+		if bits.OnesCount(uint(misses)) > 1 {
+			return nil
+		}
+
+		// Expression is evaluated last, as the most expensive criterion
+		if filter.Expression != nil {
+			matched, err := filter.Expression.Matches(*part)
+			if err != nil || !matched {
+				return err
+			}
+		}
+
+		counter.add(part, misses)
+		return nil
+	}
+
+	if uuids, ok := i.indexes.plan(base, i.parts); ok {
+		for _, uuid := range uuids {
+			if err := count(i.parts[uuid]); err != nil {
+				return model.PartFacets{}, err
+			}
+		}
+	} else {
+		for _, part := range i.parts {
+			if err := count(part); err != nil {
+				return model.PartFacets{}, err
+			}
+		}
+	}
+
+	return counter.result(request.Limit), nil
+}
+
+// facetMisses returns facet criteria of the filter the part doesn't match
+func facetMisses(filter model.PartFilter, part *model.Part) facet {
+	var misses facet
+	if len(filter.Categories) > 0 && !slices.Contains(filter.Categories, part.Category) {
+		misses |= facetCategory
+	}
+	if len(filter.ManufacturerCountries) > 0 && !slices.ContainsFunc(filter.ManufacturerCountries, func(country string) bool {
+		return strings.EqualFold(part.Manufacturer.Country, country)
+	}) {
+		misses |= facetCountry
+	}
+	if len(filter.Tags) > 0 && !slices.ContainsFunc(filter.Tags, func(tag string) bool {
+		return slices.ContainsFunc(part.Tags, func(partTag string) bool { return strings.EqualFold(partTag, tag) })
+	}) {
+		misses |= facetTag
+	}
+	if !filter.Price.Contains(part.Price) {
+		misses |= facetPrice
+	}
+	return misses
+}
+
+// valueCounter counts parts per normalized value, keeping the lowest spelling for display
+type valueCounter struct {
+	counts   map[string]int
+	spelling map[string]string
+}
+
+func newValueCounter() valueCounter {
+	return valueCounter{
+		counts:   make(map[string]int),
+		spelling: make(map[string]string),
+	}
+}
+
+// add counts the value once
+func (c valueCounter) add(value string) {
+	key := normalizeKey(value)
+	c.counts[key]++
+	if spelling, ok := c.spelling[key]; !ok || value < spelling {
+		c.spelling[key] = value
+	}
+}
+
+// result returns up to limit most frequent values
+func (c valueCounter) result(limit int) []model.ValueCount {
+	result := make([]model.ValueCount, 0, len(c.counts))
+	for key, count := range c.counts {
+		result = append(result, model.ValueCount{Value: c.spelling[key], Count: count})
+	}
+
+	slices.SortFunc(result, func(a, b model.ValueCount) int {
+		if c := cmp.Compare(b.Count, a.Count); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Value, b.Value)
+	})
+
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result
+}
+
+// facetCounter accumulates counts of all facets
+type facetCounter struct {
+	categories  map[model.PartCategory]int
+	countries   valueCounter
+	tags        valueCounter
+	priceBounds []float64
+	prices      []int // Bucket i holds prices in [priceBounds[i-1], priceBounds[i])
+}
+
+func newFacetCounter(priceBounds []float64) *facetCounter {
+	return &facetCounter{
+		categories:  make(map[model.PartCategory]int),
+		countries:   newValueCounter(),
+		tags:        newValueCounter(),
+		priceBounds: priceBounds,
+		prices:      make([]int, len(priceBounds)+1),
+	}
+}
+
+// add counts the part in every facet whose criterion it doesn't miss
+// Facet criteria the part misses are ignored by their own facets only
+func (c *facetCounter) add(part *model.Part, misses facet) {
+	counts := func(f facet) bool {
+		return misses&^f == 0
+	}
+
+	if counts(facetCategory) {
+		c.categories[part.Category]++
+	}
+	if counts(facetCountry) {
+		c.countries.add(part.Manufacturer.Country)
+	}
+	if counts(facetTag) {
+		// Count every tag once per part
+		seen := make(map[string]struct{}, len(part.Tags))
+		for _, tag := range part.Tags {
+			if _, ok := seen[normalizeKey(tag)]; !ok {
+				seen[normalizeKey(tag)] = struct{}{}
+				c.tags.add(tag)
+			}
+		}
+	}
+	if counts(facetPrice) {
+		bucket, found := slices.BinarySearch(c.priceBounds, part.Price)
+		if found {
+			bucket++ // Lower bound belongs to the bucket above it
+		}
+		c.prices[bucket]++
+	}
+}
+
+// result returns accumulated counts, limiting number of country and tag values
+func (c *facetCounter) result(limit int) model.PartFacets {
+	categories := make([]model.CategoryCount, 0, len(c.categories))
+	for category, count := range c.categories {
+		categories = append(categories, model.CategoryCount{Category: category, Count: count})
+	}
+	slices.SortFunc(categories, func(a, b model.CategoryCount) int {
+		if c := cmp.Compare(b.Count, a.Count); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Category, b.Category)
+	})
+
+	buckets := make([]model.PriceBucket, len(c.prices))
+	for i, count := range c.prices {
+		buckets[i].Count = count
+		if i > 0 {
+			buckets[i].Min = &c.priceBounds[i-1]
+		}
+		if i < len(c.priceBounds) {
+			buckets[i].Max = &c.priceBounds[i]
+		}
+	}
+
+	return model.PartFacets{
+		Categories:   categories,
+		Countries:    c.countries.result(limit),
+		Tags:         c.tags.result(limit),
+		PriceBuckets: buckets,
+	}
+}
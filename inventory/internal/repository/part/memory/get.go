@@ -10,16 +10,27 @@ import (
 // GetPartList retrieves parts matching the filter criteria
 // Thread-safe read operation using RWMutex
 // Filtering logic:
-// - Empty filter returns all parts
-// - OR logic within each filter field
-// - AND logic between different filter fields
+//   - Empty filter returns all parts
+//   - OR logic within each filter field
+//   - AND logic between different filter fields
+//   - Inclusive bounds for price, stock and dimension ranges
+//   - UUIDs, names, categories, countries and tags are looked up in secondary indexes,
+//     starting with the most selective one
+//   - Filter expression is evaluated last, on parts matching all other criteria
+//
 // Returns:
 // - Slice of matching parts
-// - nil error if successful
+// - error if evaluation of the filter expression failed
 func (i *inventoryRepository) GetPartList(ctx context.Context, filter model.PartFilter) ([]model.Part, error) {
 	i.mu.RLock()         // Acquire read lock
 	defer i.mu.RUnlock() // Ensure lock is released
 
+	return i.filterParts(filter)
+}
+
+// filterParts returns copies of parts matching the filter
+// Caller must hold the read lock
+func (i *inventoryRepository) filterParts(filter model.PartFilter) ([]model.Part, error) {
 	// Return all parts if no filters specified
 	if isEmptyFilter(filter) {
 		parts := make([]model.Part, 0, len(i.parts))
@@ -31,32 +42,26 @@ func (i *inventoryRepository) GetPartList(ctx context.Context, filter model.Part
 
 	var result []model.Part
 
-	// First filter pass - by UUIDs (OR logic)
-	if len(filter.UUIDs) > 0 {
-		for _, uuid := range filter.UUIDs {
-			if part, exists := i.parts[uuid]; exists {
-				result = append(result, *part)
-			}
+	// Narrow down parts by secondary indexes, copying only the matching ones
+	if uuids, ok := i.indexes.plan(filter, i.parts); ok {
+		result = make([]model.Part, 0, len(uuids))
+		for _, uuid := range uuids {
+			result = append(result, *i.parts[uuid])
 		}
 	} else {
-		// If no UUID filter, start with all parts
+		// If no indexed criteria, start with all parts
+		result = make([]model.Part, 0, len(i.parts))
 		for _, part := range i.parts {
 			result = append(result, *part)
 		}
 	}
 
 	// Apply subsequent filters (AND logic between fields)
-	if len(filter.Names) > 0 {
-		result = filterByName(result, filter.Names)
-	}
-	if len(filter.Categories) > 0 {
-		result = filterByCategory(result, filter.Categories)
-	}
-	if len(filter.ManufacturerCountries) > 0 {
-		result = filterByCountry(result, filter.ManufacturerCountries)
+	if filter.HasRanges() {
+		result = filterByRanges(result, filter)
 	}
-	if len(filter.Tags) > 0 {
-		result = filterByTags(result, filter.Tags)
+	if filter.Expression != nil {
+		return filterByExpression(result, filter.Expression)
 	}
 
 	return result, nil
@@ -0,0 +1,191 @@
+package memory
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+)
+
+// uuidSet is a set of part UUIDs
+type uuidSet map[string]struct{}
+
+// secondaryIndexes map values of filterable fields to UUIDs of parts having them
+// String keys are normalized, so lookups are case-insensitive like the filters
+// Indexes are maintained on every write and must be accessed under the repository lock
+type secondaryIndexes struct {
+	byName     map[string]uuidSet
+	byCategory map[model.PartCategory]uuidSet
+	byCountry  map[string]uuidSet
+	byTag      map[string]uuidSet
+}
+
+// newSecondaryIndexes creates empty indexes
+func newSecondaryIndexes() *secondaryIndexes {
+	return &secondaryIndexes{
+		byName:     make(map[string]uuidSet),
+		byCategory: make(map[model.PartCategory]uuidSet),
+		byCountry:  make(map[string]uuidSet),
+		byTag:      make(map[string]uuidSet),
+	}
+}
+
+// add indexes field values of the part
+func (x *secondaryIndexes) add(part *model.Part) {
+	addKey(x.byName, normalizeKey(part.Name), part.Uuid)
+	addKey(x.byCategory, part.Category, part.Uuid)
+	addKey(x.byCountry, normalizeKey(part.Manufacturer.Country), part.Uuid)
+	for _, tag := range part.Tags {
+		addKey(x.byTag, normalizeKey(tag), part.Uuid)
+	}
+}
+
+// remove drops field values of the part from indexes
+// The part must be the same version that was added
+func (x *secondaryIndexes) remove(part *model.Part) {
+	removeKey(x.byName, normalizeKey(part.Name), part.Uuid)
+	removeKey(x.byCategory, part.Category, part.Uuid)
+	removeKey(x.byCountry, normalizeKey(part.Manufacturer.Country), part.Uuid)
+	for _, tag := range part.Tags {
+		removeKey(x.byTag, normalizeKey(tag), part.Uuid)
+	}
+}
+
+// criterion is an indexed filter field: parts having any of the field values match it
+type criterion struct {
+	sets     []uuidSet // Sets of parts having each of the requested values
+	estimate int       // Upper bound of number of matching parts
+}
+
+// contains reports whether the part matches the criterion
+func (c criterion) contains(uuid string) bool {
+	for _, set := range c.sets {
+		if _, ok := set[uuid]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// plan returns UUIDs of parts matching all indexed criteria of the filter
+// The most selective criterion is enumerated, the others are checked by set lookups
+// Returns false if the filter has no indexed criteria, so all parts have to be scanned
+func (x *secondaryIndexes) plan(filter model.PartFilter, parts map[string]*model.Part) ([]string, bool) {
+	var criteria []criterion
+	if len(filter.UUIDs) > 0 {
+		set := make(uuidSet, len(filter.UUIDs))
+		for _, uuid := range filter.UUIDs {
+			if _, exists := parts[uuid]; exists {
+				set[uuid] = struct{}{}
+			}
+		}
+		criteria = append(criteria, criterion{sets: []uuidSet{set}, estimate: len(set)})
+	}
+	if len(filter.Names) > 0 {
+		criteria = append(criteria, lookup(x.byName, filter.Names, normalizeKey))
+	}
+	if len(filter.Categories) > 0 {
+		criteria = append(criteria, lookup(x.byCategory, filter.Categories, func(c model.PartCategory) model.PartCategory { return c }))
+	}
+	if len(filter.ManufacturerCountries) > 0 {
+		criteria = append(criteria, lookup(x.byCountry, filter.ManufacturerCountries, normalizeKey))
+	}
+	if len(filter.Tags) > 0 {
+		criteria = append(criteria, lookup(x.byTag, filter.Tags, normalizeKey))
+	}
+	if len(criteria) == 0 {
+		return nil, false
+	}
+
+	slices.SortFunc(criteria, func(a, b criterion) int {
+		return a.estimate - b.estimate
+	})
+
+	// Union of the most selective criterion, deduplicated as a part may have several of the values
+	driver, rest := criteria[0], criteria[1:]
+	seen := make(uuidSet, driver.estimate)
+	result := make([]string, 0, driver.estimate)
+	for _, set := range driver.sets {
+		for uuid := range set {
+			if _, ok := seen[uuid]; ok {
+				continue
+			}
+			seen[uuid] = struct{}{}
+
+			if matchesAll(rest, uuid) {
+				result = append(result, uuid)
+			}
+		}
+	}
+
+	return result, true
+}
+
+// lookup collects index sets of the requested values
+func lookup[K comparable, V any](index map[K]uuidSet, values []V, key func(V) K) criterion {
+	var c criterion
+	for _, value := range values {
+		if set, ok := index[key(value)]; ok {
+			c.sets = append(c.sets, set)
+			c.estimate += len(set)
+		}
+	}
+	return c
+}
+
+// matchesAll reports whether the part matches every criterion
+func matchesAll(criteria []criterion, uuid string) bool {
+	for _, c := range criteria {
+		if !c.contains(uuid) {
+			return false
+		}
+	}
+	return true
+}
+
+// addKey adds the UUID to the set of the key
+func addKey[K comparable](index map[K]uuidSet, key K, uuid string) {
+	set, ok := index[key]
+	if !ok {
+		set = make(uuidSet)
+		index[key] = set
+	}
+	set[uuid] = struct{}{}
+}
+
+// removeKey removes the UUID from the set of the key, dropping empty sets
+func removeKey[K comparable](index map[K]uuidSet, key K, uuid string) {
+	set, ok := index[key]
+	if !ok {
+		return
+	}
+	delete(set, uuid)
+	if len(set) == 0 {
+		delete(index, key)
+	}
+}
+
+// normalizeKey folds case of names, countries and tags
+// Values have equal keys exactly when strings.EqualFold reports them equal, as filters compare them,
+// so "ſ" and "S" or "ς" and "Σ" share a key while "ß" and "SS" don't
+func normalizeKey(value string) string {
+	return strings.Map(foldRune, value)
+}
+
+// foldRune maps the rune to the smallest rune of its simple case folding orbit, e.g. k and Kelvin sign to K
+func foldRune(r rune) rune {
+	switch {
+	case 'a' <= r && r <= 'z':
+		return r - 'a' + 'A' // ASCII letters have no smaller orbit members than their upper case
+	case r < utf8.RuneSelf:
+		return r
+	}
+
+	folded := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		folded = min(folded, f)
+	}
+	return folded
+}
@@ -0,0 +1,125 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+)
+
+// Fixture size and cardinality of indexed fields
+const (
+	benchParts     = 100_000
+	benchNames     = 10_000 // 10 parts per name
+	benchCountries = 20
+	benchTags      = 50
+	benchPartTags  = 3
+)
+
+// newBenchRepository fills a repository with benchParts parts spread evenly over the indexed values
+func newBenchRepository(b *testing.B) *inventoryRepository {
+	b.Helper()
+
+	categories := []model.PartCategory{
+		model.PartCategoryEngine,
+		model.PartCategoryFuel,
+		model.PartCategoryPorthole,
+		model.PartCategoryWing,
+	}
+
+	repo := NewInventoryRepository().(*inventoryRepository)
+	for n := range benchParts {
+		tags := make([]string, 0, benchPartTags)
+		for t := range benchPartTags {
+			tags = append(tags, fmt.Sprintf("tag-%d", (n+t*17)%benchTags))
+		}
+
+		part := model.Part{
+			Uuid:     fmt.Sprintf("00000000-0000-0000-0000-%012d", n),
+			Name:     fmt.Sprintf("Part %d", n%benchNames),
+			Price:    float64(n % 1000),
+			Category: categories[n%len(categories)],
+			Manufacturer: model.Manufacturer{
+				Name:    fmt.Sprintf("Manufacturer %d", n%100),
+				Country: fmt.Sprintf("Country %d", n%benchCountries),
+			},
+			Tags: tags,
+		}
+		if err := repo.AddPart(context.Background(), part); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	return repo
+}
+
+// scanParts is the linear scan baseline: every part is checked against the whole filter
+func scanParts(repo *inventoryRepository, filter model.PartFilter) ([]model.Part, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	var result []model.Part
+	for _, part := range repo.parts {
+		matched, err := filter.Matches(*part)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			result = append(result, *part)
+		}
+	}
+	return result, nil
+}
+
+func BenchmarkGetPartList(b *testing.B) {
+	repo := newBenchRepository(b)
+
+	filters := []struct {
+		name   string
+		filter model.PartFilter
+	}{
+		{"name", model.PartFilter{Names: []string{"part 42"}}},
+		{"category", model.PartFilter{Categories: []model.PartCategory{model.PartCategoryEngine}}},
+		{"country", model.PartFilter{ManufacturerCountries: []string{"COUNTRY 7"}}},
+		{"tag", model.PartFilter{Tags: []string{"Tag-3"}}},
+		{"combined", model.PartFilter{
+			Categories:            []model.PartCategory{model.PartCategoryFuel},
+			ManufacturerCountries: []string{"country 13", "country 17"},
+			Tags:                  []string{"tag-10"},
+		}},
+	}
+
+	for _, f := range filters {
+		// Both paths must return the same parts, otherwise the comparison is meaningless
+		indexed, err := repo.GetPartList(context.Background(), f.filter)
+		if err != nil {
+			b.Fatal(err)
+		}
+		scanned, err := scanParts(repo, f.filter)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(indexed) == 0 || len(indexed) != len(scanned) {
+			b.Fatalf("%s: indexed lookup found %d parts, scan found %d", f.name, len(indexed), len(scanned))
+		}
+
+		b.Run(f.name+"/indexed", func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := repo.GetPartList(context.Background(), f.filter); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+
+		b.Run(f.name+"/scan", func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := scanParts(repo, f.filter); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
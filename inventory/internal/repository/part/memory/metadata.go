@@ -0,0 +1,21 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+)
+
+// GetMetadataSchema collects metadata keys of all parts with kinds of their values
+// Thread-safe read operation using RWMutex
+func (i *inventoryRepository) GetMetadataSchema(ctx context.Context) (model.MetadataSchema, error) {
+	i.mu.RLock()         // Acquire read lock
+	defer i.mu.RUnlock() // Ensure lock is released
+
+	schema := make(model.MetadataSchema)
+	for _, part := range i.parts {
+		schema.Add(part.Metadata)
+	}
+
+	return schema, nil
+}
@@ -0,0 +1,76 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+)
+
+// GetPartPage retrieves a page of parts matching the filter in the requested order
+// Thread-safe read operation using RWMutex
+// The page starts right after the cursor of the request, so parts added or removed
+// before it don't shift the following pages
+// Returns:
+// - Page of matching parts with cursor of the next page
+// - error if evaluation of the filter expression failed
+func (i *inventoryRepository) GetPartPage(ctx context.Context, filter model.PartFilter, page model.PartPageRequest) (model.PartPage, error) {
+	i.mu.RLock() // Acquire read lock
+	parts, err := i.filterParts(filter)
+	i.mu.RUnlock() // Sorting works on copies, no need to hold the lock
+	if err != nil {
+		return model.PartPage{}, err
+	}
+
+	slices.SortFunc(parts, func(a, b model.Part) int {
+		return compareCursors(page.Order, model.CursorOf(a), model.CursorOf(b))
+	})
+
+	// Skip parts up to and including the cursor
+	start := 0
+	if page.After != nil {
+		start, _ = slices.BinarySearchFunc(parts, *page.After, func(part model.Part, after model.PartCursor) int {
+			if compareCursors(page.Order, model.CursorOf(part), after) <= 0 {
+				return -1
+			}
+			return 1
+		})
+	}
+	end := min(start+page.Size, len(parts))
+
+	result := model.PartPage{
+		Parts:     parts[start:end],
+		TotalSize: len(parts),
+	}
+	if end < len(parts) && end > start {
+		next := model.CursorOf(parts[end-1])
+		result.Next = &next
+	}
+
+	return result, nil
+}
+
+// compareCursors compares positions by the ordering field and then by UUID
+func compareCursors(order model.PartOrder, a, b model.PartCursor) int {
+	var result int
+	switch order.Field {
+	case model.PartSortFieldName:
+		result = strings.Compare(a.Name, b.Name)
+	case model.PartSortFieldPrice:
+		result = cmp.Compare(a.Price, b.Price)
+	case model.PartSortFieldStock:
+		result = cmp.Compare(a.StockQuantity, b.StockQuantity)
+	case model.PartSortFieldCreatedAt:
+		result = a.CreatedAt.Compare(b.CreatedAt)
+	}
+	if result == 0 {
+		result = strings.Compare(a.UUID, b.UUID)
+	}
+
+	if order.Descending {
+		return -result
+	}
+	return result
+}
@@ -1,7 +1,6 @@
 package memory
 
 import (
-	"strings"
 	"sync"
 
 	"github.com/andredubov/rocket-factory/inventory/internal/repository"
@@ -9,81 +8,48 @@ import (
 )
 
 // inventoryRepository is an in-memory implementation of the Inventory repository
-// Uses a map for storage and RWMutex for concurrent access safety
+// Uses a map for storage, secondary indexes for filtering and RWMutex for concurrent access safety
 type inventoryRepository struct {
-	mu    sync.RWMutex           // Read-write mutex to protect concurrent access
-	parts map[string]*model.Part // Map storing parts by their UUID
+	mu      sync.RWMutex           // Read-write mutex to protect concurrent access
+	parts   map[string]*model.Part // Map storing parts by their UUID
+	indexes *secondaryIndexes      // Indexes of filterable fields, updated with parts
+	ledger  *stockLedger           // Movements of stock of all parts
 }
 
 // NewInventoryRepository creates a new in-memory inventory repository instance
 // Returns a ready-to-use repository with initialized storage map
 func NewInventoryRepository() repository.Inventory {
 	return &inventoryRepository{
-		parts: make(map[string]*model.Part), // Initialize empty parts map
+		parts:   make(map[string]*model.Part), // Initialize empty parts map
+		indexes: newSecondaryIndexes(),        // Initialize empty indexes
+		ledger:  newStockLedger(),             // Initialize empty ledger
 	}
 }
 
-// Filter by tags (OR logic within tags, part must have at least one matching tag)
-func filterByTags(parts []model.Part, tags []string) []model.Part {
+// Filter by price, stock and dimension ranges (all ranges must match)
+func filterByRanges(parts []model.Part, filter model.PartFilter) []model.Part {
 	var result []model.Part
 	for _, part := range parts {
-		partMatched := false
-		for _, tag := range tags {
-			for _, partTag := range part.Tags {
-				if strings.EqualFold(partTag, tag) {
-					result = append(result, part)
-					partMatched = true
-					break
-				}
-			}
-			if partMatched {
-				break
-			}
+		if filter.MatchesRanges(part) {
+			result = append(result, part)
 		}
 	}
 	return result
 }
 
-// Filter by manufacturer country (OR logic within countries)
-func filterByCountry(parts []model.Part, countries []string) []model.Part {
+// Filter by compiled filter expression
+func filterByExpression(parts []model.Part, expression model.PartPredicate) ([]model.Part, error) {
 	var result []model.Part
 	for _, part := range parts {
-		for _, country := range countries {
-			if strings.EqualFold(part.Manufacturer.Country, country) {
-				result = append(result, part)
-				break
-			}
+		matched, err := expression.Matches(part)
+		if err != nil {
+			return nil, err
 		}
-	}
-	return result
-}
-
-// Filter by category (OR logic within categories)
-func filterByCategory(parts []model.Part, categories []model.PartCategory) []model.Part {
-	var result []model.Part
-	for _, part := range parts {
-		for _, cat := range categories {
-			if part.Category == cat {
-				result = append(result, part)
-				break
-			}
-		}
-	}
-	return result
-}
-
-// Filter by name (OR logic within names)
-func filterByName(parts []model.Part, names []string) []model.Part {
-	var result []model.Part
-	for _, part := range parts {
-		for _, name := range names {
-			if strings.EqualFold(part.Name, name) {
-				result = append(result, part)
-				break
-			}
+		if matched {
+			result = append(result, part)
 		}
 	}
-	return result
+	return result, nil
 }
 
 // Helper function to check if filter is empty
@@ -92,5 +58,7 @@ func isEmptyFilter(filter model.PartFilter) bool {
 		len(filter.Names) == 0 &&
 		len(filter.Categories) == 0 &&
 		len(filter.ManufacturerCountries) == 0 &&
-		len(filter.Tags) == 0
+		len(filter.Tags) == 0 &&
+		!filter.HasRanges() &&
+		filter.Expression == nil
 }
@@ -0,0 +1,128 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository"
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+)
+
+// stockLedger is an append-only log of stock movements
+// Movements of deleted parts are kept for audit
+type stockLedger struct {
+	movements       []model.StockMovement // All movements in sequence order, sequence is index + 1
+	byPart          map[string][]int      // Part UUID -> indexes of its movements
+	idempotencyKeys map[string]int        // Idempotency key -> index of its movement
+}
+
+func newStockLedger() *stockLedger {
+	return &stockLedger{
+		byPart:          make(map[string][]int),
+		idempotencyKeys: make(map[string]int),
+	}
+}
+
+// append records the movement of the adjustment, assigning it the next sequence
+func (l *stockLedger) append(adjustment model.StockAdjustment, quantityAfter int64, createdAt time.Time) model.StockMovement {
+	movement := model.StockMovement{
+		Sequence:      uint64(len(l.movements)) + 1,
+		Adjustment:    adjustment,
+		QuantityAfter: quantityAfter,
+		CreatedAt:     createdAt,
+	}
+
+	l.movements = append(l.movements, movement)
+	l.byPart[adjustment.PartUUID] = append(l.byPart[adjustment.PartUUID], len(l.movements)-1)
+	if len(adjustment.IdempotencyKey) > 0 {
+		l.idempotencyKeys[adjustment.IdempotencyKey] = len(l.movements) - 1
+	}
+
+	return movement
+}
+
+// recordChange records stock change made by replacing the part, if any
+// Such movements have no actor and idempotency key
+func (l *stockLedger) recordChange(uuid string, before, after int64, reason model.StockReason, createdAt time.Time) {
+	if before == after {
+		return
+	}
+
+	l.append(model.StockAdjustment{
+		PartUUID: uuid,
+		Delta:    after - before,
+		Reason:   reason,
+	}, after, createdAt)
+}
+
+// AdjustStock applies the signed delta to stock of the part and records the movement in the ledger
+// Thread-safe operation using mutex lock
+// Adjustment with an already used idempotency key is not applied again,
+// the movement recorded for the key is returned instead
+// Returns:
+//   - Recorded movement
+//   - error if part doesn't exist, stock would go negative
+//     or the idempotency key was used for another adjustment
+func (i *inventoryRepository) AdjustStock(ctx context.Context, adjustment model.StockAdjustment) (model.StockAdjustmentResult, error) {
+	i.mu.Lock()         // Acquire write lock
+	defer i.mu.Unlock() // Ensure lock is released
+
+	if index, ok := i.ledger.idempotencyKeys[adjustment.IdempotencyKey]; ok {
+		movement := i.ledger.movements[index]
+		if movement.Adjustment != adjustment {
+			return model.StockAdjustmentResult{}, repository.ErrIdempotencyKeyReused
+		}
+		return model.StockAdjustmentResult{Movement: movement, Replayed: true}, nil
+	}
+
+	part, exists := i.parts[adjustment.PartUUID]
+	if !exists {
+		return model.StockAdjustmentResult{}, repository.ErrPartWithUUIDNotFound(adjustment.PartUUID)
+	}
+
+	quantity := part.StockQuantity + adjustment.Delta
+	if quantity < 0 {
+		return model.StockAdjustmentResult{}, repository.ErrStockWouldGoNegative(part.Uuid, part.StockQuantity, adjustment.Delta)
+	}
+
+	// Replace the part instead of modifying it, readers may hold the previous version
+	now := time.Now()
+	updatedPart := *part
+	updatedPart.StockQuantity = quantity
+	updatedPart.UpdatedAt = now
+	i.parts[part.Uuid] = &updatedPart
+
+	movement := i.ledger.append(adjustment, quantity, now)
+	return model.StockAdjustmentResult{Movement: movement}, nil
+}
+
+// ListStockMovements retrieves a page of ledger entries in sequence order
+// Thread-safe read operation using RWMutex
+func (i *inventoryRepository) ListStockMovements(ctx context.Context, filter model.StockMovementFilter) (model.StockMovementPage, error) {
+	i.mu.RLock()         // Acquire read lock
+	defer i.mu.RUnlock() // Ensure lock is released
+
+	movements := i.ledger.movements
+	after := int(min(filter.AfterSequence, uint64(len(movements)))) // #nosec G115 -- capped at ledger length
+
+	var page model.StockMovementPage
+	if len(filter.PartUUID) > 0 {
+		// Indexes of part movements are sorted, so the page starts right after the cursor
+		indexes := i.ledger.byPart[filter.PartUUID]
+		start, _ := slices.BinarySearch(indexes, after)
+		for _, index := range indexes[start:] {
+			if len(page.Movements) == filter.Limit {
+				page.HasMore = true
+				break
+			}
+			page.Movements = append(page.Movements, movements[index])
+		}
+	} else {
+		rest := movements[after:]
+		page.HasMore = len(rest) > filter.Limit
+		page.Movements = slices.Clone(rest[:min(len(rest), filter.Limit)])
+	}
+
+	return page, nil
+}
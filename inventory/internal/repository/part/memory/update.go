@@ -2,23 +2,31 @@ package memory
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	"github.com/andredubov/rocket-factory/inventory/internal/repository"
 	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
 )
 
 // UpdatePart modifies an existing part in the repository
+// Changed stock is recorded in the ledger as a correction
 func (p *inventoryRepository) UpdatePart(ctx context.Context, part model.Part) error {
 	p.mu.Lock()         // Acquire write lock
 	defer p.mu.Unlock() // Ensure lock is released
 
 	// Verify part exists before update
-	if _, exists := p.parts[part.Uuid]; !exists {
+	existing, exists := p.parts[part.Uuid]
+	if !exists {
 		return repository.ErrPartWithUUIDNotFound(part.Uuid)
 	}
 
 	// Create defensive copy to prevent external modifications
 	updatedPart := part
+	updatedPart.Tags = slices.Clone(part.Tags)
+	p.indexes.remove(existing)
 	p.parts[part.Uuid] = &updatedPart
+	p.indexes.add(&updatedPart)
+	p.ledger.recordChange(part.Uuid, existing.StockQuantity, part.StockQuantity, model.StockReasonCorrection, time.Now())
 	return nil
 }
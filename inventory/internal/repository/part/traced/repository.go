@@ -0,0 +1,154 @@
+package traced
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository"
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+	"github.com/andredubov/rocket-factory/shared/pkg/tracing"
+)
+
+const tracerName = "github.com/andredubov/rocket-factory/inventory/internal/repository"
+
+// inventoryRepository wraps another Inventory implementation and records a span per call
+type inventoryRepository struct {
+	next   repository.Inventory
+	tracer trace.Tracer
+}
+
+// NewInventoryRepository creates tracing decorator around the given inventory repository
+func NewInventoryRepository(next repository.Inventory) repository.Inventory {
+	return &inventoryRepository{
+		next:   next,
+		tracer: tracing.Tracer(tracerName),
+	}
+}
+
+// GetPartList retrieves parts matching the filter
+func (r *inventoryRepository) GetPartList(ctx context.Context, filter model.PartFilter) (parts []model.Part, err error) {
+	ctx, span := r.tracer.Start(ctx, "InventoryRepository.GetPartList")
+	defer func() {
+		span.SetAttributes(attribute.Int("parts.count", len(parts)))
+		tracing.End(span, err)
+	}()
+
+	return r.next.GetPartList(ctx, filter)
+}
+
+// GetPartPage retrieves a page of parts matching the filter
+func (r *inventoryRepository) GetPartPage(ctx context.Context, filter model.PartFilter, page model.PartPageRequest) (result model.PartPage, err error) {
+	ctx, span := r.tracer.Start(ctx, "InventoryRepository.GetPartPage",
+		trace.WithAttributes(
+			attribute.String("page.order_by", string(page.Order.Field)),
+			attribute.Int("page.size", page.Size),
+		))
+	defer func() {
+		span.SetAttributes(
+			attribute.Int("parts.count", len(result.Parts)),
+			attribute.Int("parts.total", result.TotalSize),
+		)
+		tracing.End(span, err)
+	}()
+
+	return r.next.GetPartPage(ctx, filter, page)
+}
+
+// GetPartFacets counts parts matching the filter per value of filterable fields
+func (r *inventoryRepository) GetPartFacets(ctx context.Context, filter model.PartFilter, request model.FacetRequest) (facets model.PartFacets, err error) {
+	ctx, span := r.tracer.Start(ctx, "InventoryRepository.GetPartFacets",
+		trace.WithAttributes(
+			attribute.Int("facets.limit", request.Limit),
+			attribute.Int("facets.price_buckets", len(request.PriceBounds)+1),
+		))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.GetPartFacets(ctx, filter, request)
+}
+
+// GetPart retrieves a single part by its UUID
+func (r *inventoryRepository) GetPart(ctx context.Context, uuid string) (part *model.Part, err error) {
+	ctx, span := r.tracer.Start(ctx, "InventoryRepository.GetPart",
+		trace.WithAttributes(attribute.String("part.uuid", uuid)))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.GetPart(ctx, uuid)
+}
+
+// GetMetadataSchema collects metadata keys of all parts with kinds of their values
+func (r *inventoryRepository) GetMetadataSchema(ctx context.Context) (schema model.MetadataSchema, err error) {
+	ctx, span := r.tracer.Start(ctx, "InventoryRepository.GetMetadataSchema")
+	defer func() {
+		span.SetAttributes(attribute.Int("metadata.keys", len(schema)))
+		tracing.End(span, err)
+	}()
+
+	return r.next.GetMetadataSchema(ctx)
+}
+
+// AddPart stores a new part
+func (r *inventoryRepository) AddPart(ctx context.Context, part model.Part) (err error) {
+	ctx, span := r.tracer.Start(ctx, "InventoryRepository.AddPart",
+		trace.WithAttributes(attribute.String("part.uuid", part.Uuid)))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.AddPart(ctx, part)
+}
+
+// UpdatePart replaces an existing part
+func (r *inventoryRepository) UpdatePart(ctx context.Context, part model.Part) (err error) {
+	ctx, span := r.tracer.Start(ctx, "InventoryRepository.UpdatePart",
+		trace.WithAttributes(attribute.String("part.uuid", part.Uuid)))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.UpdatePart(ctx, part)
+}
+
+// DeletePart removes a part by its UUID
+func (r *inventoryRepository) DeletePart(ctx context.Context, uuid string) (err error) {
+	ctx, span := r.tracer.Start(ctx, "InventoryRepository.DeletePart",
+		trace.WithAttributes(attribute.String("part.uuid", uuid)))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.DeletePart(ctx, uuid)
+}
+
+// AdjustStock applies a signed delta to stock of a part
+func (r *inventoryRepository) AdjustStock(ctx context.Context, adjustment model.StockAdjustment) (result model.StockAdjustmentResult, err error) {
+	ctx, span := r.tracer.Start(ctx, "InventoryRepository.AdjustStock",
+		trace.WithAttributes(
+			attribute.String("part.uuid", adjustment.PartUUID),
+			attribute.Int64("stock.delta", adjustment.Delta),
+			attribute.String("stock.reason", string(adjustment.Reason)),
+		))
+	defer func() {
+		span.SetAttributes(attribute.Bool("stock.replayed", result.Replayed))
+		tracing.End(span, err)
+	}()
+
+	return r.next.AdjustStock(ctx, adjustment)
+}
+
+// ListStockMovements retrieves a page of stock ledger entries
+func (r *inventoryRepository) ListStockMovements(ctx context.Context, filter model.StockMovementFilter) (page model.StockMovementPage, err error) {
+	ctx, span := r.tracer.Start(ctx, "InventoryRepository.ListStockMovements",
+		trace.WithAttributes(attribute.String("part.uuid", filter.PartUUID)))
+	defer func() {
+		span.SetAttributes(attribute.Int("movements.count", len(page.Movements)))
+		tracing.End(span, err)
+	}()
+
+	return r.next.ListStockMovements(ctx, filter)
+}
+
+// Ping is not traced, it is called by the readiness watcher only
+func (r *inventoryRepository) Ping(ctx context.Context) error {
+	return r.next.Ping(ctx)
+}
+
+// Close releases the wrapped repository
+func (r *inventoryRepository) Close() error {
+	return r.next.Close()
+}
@@ -0,0 +1,104 @@
+package watched
+
+import (
+	"context"
+	"sync"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository"
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+	"github.com/andredubov/rocket-factory/inventory/internal/watch"
+)
+
+// inventoryRepository wraps another Inventory implementation and publishes
+// every successful write to the watch hub
+// Reads are served by the wrapped repository
+type inventoryRepository struct {
+	repository.Inventory
+	mu  sync.Mutex // Keeps order of events the same as order of writes
+	hub *watch.Hub
+}
+
+// NewInventoryRepository creates change publishing decorator around the given inventory repository
+func NewInventoryRepository(next repository.Inventory, hub *watch.Hub) repository.Inventory {
+	return &inventoryRepository{
+		Inventory: next,
+		hub:       hub,
+	}
+}
+
+// AddPart stores a new part and publishes its creation
+func (r *inventoryRepository) AddPart(ctx context.Context, part model.Part) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.Inventory.AddPart(ctx, part); err != nil {
+		return err
+	}
+
+	r.hub.Publish(watch.EventTypeCreated, part, nil)
+	return nil
+}
+
+// UpdatePart replaces an existing part and publishes its update with the previous version
+func (r *inventoryRepository) UpdatePart(ctx context.Context, part model.Part) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	previous, err := r.Inventory.GetPart(ctx, part.Uuid)
+	if err != nil {
+		return err
+	}
+	previousPart := *previous // Keep a copy, the wrapped repository may reuse the value
+
+	if err := r.Inventory.UpdatePart(ctx, part); err != nil {
+		return err
+	}
+
+	r.hub.Publish(watch.EventTypeUpdated, part, &previousPart)
+	return nil
+}
+
+// DeletePart removes a part and publishes its deletion with the last version
+func (r *inventoryRepository) DeletePart(ctx context.Context, uuid string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	part, err := r.Inventory.GetPart(ctx, uuid)
+	if err != nil {
+		return err
+	}
+	deleted := *part // Keep a copy, the wrapped repository may reuse the value
+
+	if err := r.Inventory.DeletePart(ctx, uuid); err != nil {
+		return err
+	}
+
+	r.hub.Publish(watch.EventTypeDeleted, deleted, nil)
+	return nil
+}
+
+// AdjustStock applies a signed delta to stock of a part and publishes the update
+// Replayed adjustments don't change the part and are not published
+func (r *inventoryRepository) AdjustStock(ctx context.Context, adjustment model.StockAdjustment) (model.StockAdjustmentResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	previous, err := r.Inventory.GetPart(ctx, adjustment.PartUUID)
+	if err != nil {
+		return r.Inventory.AdjustStock(ctx, adjustment) // Part may be deleted, replay is still possible
+	}
+	previousPart := *previous // Keep a copy, the wrapped repository may reuse the value
+
+	result, err := r.Inventory.AdjustStock(ctx, adjustment)
+	if err != nil || result.Replayed {
+		return result, err
+	}
+
+	part, err := r.Inventory.GetPart(ctx, adjustment.PartUUID)
+	if err != nil {
+		return result, err
+	}
+
+	r.hub.Publish(watch.EventTypeUpdated, *part, &previousPart)
+	return result, nil
+}
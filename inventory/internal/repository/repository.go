@@ -11,8 +11,12 @@ import (
 // Error definitions for the inventory repository.
 // These are base errors that can be wrapped with additional context.
 var (
-	ErrPartAlreadyExists = errors.New("part already exists")
-	ErrPartNotFound      = errors.New("part not found")
+	ErrPartAlreadyExists    = errors.New("part already exists")
+	ErrPartNotFound         = errors.New("part not found")
+	ErrInsufficientStock    = errors.New("insufficient stock")
+	ErrIdempotencyKeyReused = errors.New("idempotency key was used for another adjustment")
+	ErrRuleAlreadyExists    = errors.New("compatibility rule already exists")
+	ErrRuleNotFound         = errors.New("compatibility rule not found")
 )
 
 // ErrPartWithUUIDNotFound constructs a new error indicating a part with the specified UUID was not found.
@@ -25,12 +29,42 @@ func ErrPartWithUUIDExists(uuid string) error {
 	return fmt.Errorf("part with UUID %s already exists: %w", uuid, ErrPartAlreadyExists)
 }
 
+// ErrStockWouldGoNegative constructs a new error indicating the adjustment would make stock of the part negative.
+func ErrStockWouldGoNegative(uuid string, stock, delta int64) error {
+	return fmt.Errorf("stock of part with UUID %s is %d, can't apply %d: %w", uuid, stock, delta, ErrInsufficientStock)
+}
+
+// ErrRuleWithIDNotFound constructs a new error indicating a compatibility rule with the specified ID was not found.
+func ErrRuleWithIDNotFound(id string) error {
+	return fmt.Errorf("compatibility rule %s not found: %w", id, ErrRuleNotFound)
+}
+
+// ErrRuleWithIDExists constructs a new error indicating a compatibility rule with the specified ID already exists.
+func ErrRuleWithIDExists(id string) error {
+	return fmt.Errorf("compatibility rule %s already exists: %w", id, ErrRuleAlreadyExists)
+}
+
 // Inventory defines the interface for inventory repository operations.
 // All implementations must provide thread-safe access to the underlying data store.
 type Inventory interface {
 	GetPartList(ctx context.Context, filter model.PartFilter) ([]model.Part, error)
+	GetPartPage(ctx context.Context, filter model.PartFilter, page model.PartPageRequest) (model.PartPage, error)
+	GetPartFacets(ctx context.Context, filter model.PartFilter, request model.FacetRequest) (model.PartFacets, error)
 	GetPart(ctx context.Context, uuid string) (*model.Part, error)
+	GetMetadataSchema(ctx context.Context) (model.MetadataSchema, error)
 	AddPart(ctx context.Context, part model.Part) error
 	UpdatePart(ctx context.Context, part model.Part) error
 	DeletePart(ctx context.Context, uuid string) error
+	AdjustStock(ctx context.Context, adjustment model.StockAdjustment) (model.StockAdjustmentResult, error)
+	ListStockMovements(ctx context.Context, filter model.StockMovementFilter) (model.StockMovementPage, error)
+	Ping(ctx context.Context) error
+	Close() error
+}
+
+// Compatibility defines the interface for compatibility rules repository operations.
+// All implementations must provide thread-safe access to the underlying data store.
+type Compatibility interface {
+	GetRuleList(ctx context.Context) ([]model.CompatibilityRule, error)
+	AddRule(ctx context.Context, rule model.CompatibilityRule) error
+	DeleteRule(ctx context.Context, id string) error
 }
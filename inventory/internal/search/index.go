@@ -0,0 +1,289 @@
+package search
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+)
+
+// field is a searchable field of a part
+type field int
+
+const (
+	fieldName field = iota
+	fieldTags
+	fieldManufacturer
+	fieldDescription
+	numFields
+)
+
+// fieldWeights rank matches in names above matches in descriptions
+var fieldWeights = [numFields]float64{
+	fieldName:         3,
+	fieldTags:         2,
+	fieldManufacturer: 1.5,
+	fieldDescription:  1,
+}
+
+// Relevance factors of term matches, an exact match is worth the most
+const (
+	exactMatchFactor  = 1.0
+	prefixMatchFactor = 0.8
+	typoMatchFactor   = 0.6 // Divided by number of typos
+)
+
+// Query expansion limits
+const (
+	minPrefixLength = 3  // Shorter query tokens match whole terms only
+	maxExpansions   = 64 // Closest prefix and typo matches considered per query token
+)
+
+// Query limits, every token is matched against the whole vocabulary for typos,
+// so longer queries are rejected rather than searched
+const (
+	MaxQueryLength = 256 // Bytes
+	MaxQueryTokens = 16  // Tokens left after Tokenize
+)
+
+// saturation limits how much repeated occurrences of a term increase relevance (BM25 k1)
+const saturation = 1.2
+
+// posting counts occurrences of a term in every field of a part
+type posting [numFields]int
+
+// Hit is a part matching the search query
+type Hit struct {
+	UUID  string
+	Score float64
+}
+
+// Index is an in-memory inverted index over part names, descriptions, tags and manufacturers
+// It is updated incrementally and safe for concurrent use
+type Index struct {
+	mu       sync.RWMutex
+	postings map[string]map[string]*posting // Term -> part UUID -> occurrences
+	docs     map[string][]string            // Part UUID -> its unique terms
+	terms    []string                       // Sorted vocabulary for prefix lookups
+}
+
+// NewIndex creates an empty search index
+func NewIndex() *Index {
+	return &Index{
+		postings: make(map[string]map[string]*posting),
+		docs:     make(map[string][]string),
+	}
+}
+
+// Add indexes the part, replacing the previous version of it if any
+func (x *Index) Add(part model.Part) {
+	fields := [numFields]string{
+		fieldName:         part.Name,
+		fieldTags:         strings.Join(part.Tags, " "),
+		fieldManufacturer: part.Manufacturer.Name,
+		fieldDescription:  part.Description,
+	}
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	x.remove(part.Uuid)
+
+	var terms []string
+	for f, text := range fields {
+		for _, term := range Tokenize(text) {
+			docs, ok := x.postings[term]
+			if !ok {
+				docs = make(map[string]*posting)
+				x.postings[term] = docs
+				x.insertTerm(term)
+			}
+
+			p, ok := docs[part.Uuid]
+			if !ok {
+				p = &posting{}
+				docs[part.Uuid] = p
+				terms = append(terms, term)
+			}
+			p[f]++
+		}
+	}
+
+	x.docs[part.Uuid] = terms
+}
+
+// Remove drops the part from the index
+func (x *Index) Remove(uuid string) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	x.remove(uuid)
+}
+
+// Len returns number of indexed parts
+func (x *Index) Len() int {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	return len(x.docs)
+}
+
+// Search returns parts matching the query ordered by relevance, most relevant first
+// Every query token matches terms equal to it, starting with it or differing by a few typos
+// Parts matching more query tokens, in more important fields, by rarer terms rank higher
+func (x *Index) Search(query string) []Hit {
+	tokens := Tokenize(query)
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	total := float64(len(x.docs))
+	scores := make(map[string]float64)
+	matched := make(map[string]int)
+	for _, token := range tokens {
+		// Best match of the token per part
+		best := make(map[string]float64)
+		for term, factor := range x.expand(token) {
+			docs := x.postings[term]
+			idf := math.Log(1 + (total-float64(len(docs))+0.5)/(float64(len(docs))+0.5))
+			for uuid, p := range docs {
+				var tf float64
+				for f, count := range p {
+					tf += fieldWeights[f] * float64(count)
+				}
+
+				score := factor * idf * tf * (saturation + 1) / (tf + saturation)
+				best[uuid] = max(best[uuid], score)
+			}
+		}
+
+		for uuid, score := range best {
+			scores[uuid] += score
+			matched[uuid]++
+		}
+	}
+
+	hits := make([]Hit, 0, len(scores))
+	for uuid, score := range scores {
+		// Parts matching only some of the query tokens are ranked lower
+		coverage := float64(matched[uuid]) / float64(len(tokens))
+		hits = append(hits, Hit{UUID: uuid, Score: score * coverage})
+	}
+
+	slices.SortFunc(hits, func(a, b Hit) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return strings.Compare(a.UUID, b.UUID)
+	})
+
+	return hits
+}
+
+// candidate is a vocabulary term matching a query token
+type candidate struct {
+	term     string
+	factor   float64 // Relevance factor of the match
+	distance int     // Edit distance from the token, runes appended for prefix matches
+}
+
+// expand returns terms of the vocabulary matching the query token with relevance factors
+// All matches are ranked by relevance factor, then by distance from the token, and only
+// the closest maxExpansions terms are kept, so the cap never drops a better match for a worse one
+// Caller must hold the read lock
+func (x *Index) expand(token string) map[string]float64 {
+	runes := []rune(token)
+	seen := make(map[string]struct{})
+	var candidates []candidate
+	if _, ok := x.postings[token]; ok {
+		candidates = append(candidates, candidate{term: token, factor: exactMatchFactor})
+		seen[token] = struct{}{}
+	}
+
+	// Terms starting with the token are adjacent in the sorted vocabulary
+	if len(runes) >= minPrefixLength {
+		start, _ := slices.BinarySearch(x.terms, token)
+		for _, term := range x.terms[start:] {
+			if !strings.HasPrefix(term, token) {
+				break
+			}
+			if _, ok := seen[term]; !ok {
+				candidates = append(candidates, candidate{
+					term:     term,
+					factor:   prefixMatchFactor,
+					distance: utf8.RuneCountInString(term) - len(runes),
+				})
+				seen[term] = struct{}{}
+			}
+		}
+	}
+
+	// Typo tolerance
+	if limit := maxTypos(len(runes)); limit > 0 {
+		for _, term := range x.terms {
+			if _, ok := seen[term]; ok {
+				continue
+			}
+			if typos := editDistance(runes, []rune(term), limit); typos <= limit {
+				candidates = append(candidates, candidate{
+					term:     term,
+					factor:   typoMatchFactor / float64(typos),
+					distance: typos,
+				})
+			}
+		}
+	}
+
+	slices.SortFunc(candidates, func(a, b candidate) int {
+		if c := cmp.Compare(b.factor, a.factor); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.distance, b.distance); c != 0 {
+			return c
+		}
+		return strings.Compare(a.term, b.term)
+	})
+
+	matches := make(map[string]float64, min(len(candidates), maxExpansions))
+	for _, c := range candidates[:min(len(candidates), maxExpansions)] {
+		matches[c.term] = c.factor
+	}
+
+	return matches
+}
+
+// remove drops the part from postings of its terms
+// Caller must hold the write lock
+func (x *Index) remove(uuid string) {
+	for _, term := range x.docs[uuid] {
+		docs := x.postings[term]
+		delete(docs, uuid)
+		if len(docs) == 0 {
+			delete(x.postings, term)
+			x.deleteTerm(term)
+		}
+	}
+	delete(x.docs, uuid)
+}
+
+// insertTerm adds the term to the sorted vocabulary
+func (x *Index) insertTerm(term string) {
+	i, found := slices.BinarySearch(x.terms, term)
+	if !found {
+		x.terms = slices.Insert(x.terms, i, term)
+	}
+}
+
+// deleteTerm removes the term from the sorted vocabulary
+func (x *Index) deleteTerm(term string) {
+	if i, found := slices.BinarySearch(x.terms, term); found {
+		x.terms = slices.Delete(x.terms, i, i+1)
+	}
+}
@@ -0,0 +1,52 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// minTokenLength is the shortest token kept in the index, except for numbers
+const minTokenLength = 2
+
+// stopWords are frequent English and Russian words that don't help to find a part
+var stopWords = map[string]struct{}{
+	"a": {}, "an": {}, "and": {}, "are": {}, "as": {}, "at": {}, "be": {}, "by": {}, "for": {},
+	"from": {}, "in": {}, "is": {}, "it": {}, "of": {}, "on": {}, "or": {}, "the": {}, "to": {},
+	"with": {},
+	"в":    {}, "во": {}, "на": {}, "и": {}, "или": {}, "с": {}, "со": {}, "к": {}, "по": {},
+	"для": {}, "от": {}, "до": {}, "из": {}, "за": {}, "не": {}, "что": {}, "это": {},
+}
+
+// Tokenize splits text into lowercase tokens of letters and digits
+// Both Latin and Cyrillic scripts are supported, ё is folded into е
+// Stop words and one-letter tokens are dropped, so "Main Engine RD-180"
+// becomes [main engine rd 180]
+func Tokenize(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	tokens := make([]string, 0, len(fields))
+	for _, field := range fields {
+		token := strings.ReplaceAll(field, "ё", "е")
+		if _, stop := stopWords[token]; stop {
+			continue
+		}
+		if len([]rune(token)) < minTokenLength && !isNumber(token) {
+			continue
+		}
+		tokens = append(tokens, token)
+	}
+
+	return tokens
+}
+
+// isNumber reports whether the token consists of digits only
+func isNumber(token string) bool {
+	for _, r := range token {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return len(token) > 0
+}
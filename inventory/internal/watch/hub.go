@@ -0,0 +1,199 @@
+package watch
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/andredubov/rocket-factory/inventory/internal/repository/model"
+)
+
+// Error definitions
+var (
+	ErrRevisionCompacted = errors.New("revision is no longer retained")
+	ErrFutureRevision    = errors.New("revision is ahead of the current one")
+	ErrSlowConsumer      = errors.New("subscriber is too slow to receive events")
+	ErrClosed            = errors.New("watch hub is closed")
+)
+
+// EventType defines kind of a part change
+type EventType int
+
+// Valid EventType values
+const (
+	EventTypeCreated EventType = iota + 1 // Part was created
+	EventTypeUpdated                      // Part was updated
+	EventTypeDeleted                      // Part was deleted
+)
+
+// Event is a change of a part
+type Event struct {
+	Revision uint64      // Increases with every change
+	Type     EventType   // Kind of the change
+	Part     model.Part  // Part after the change, the last version for deletion
+	Previous *model.Part // Part before the update, nil for other changes
+}
+
+// Hub assigns revisions to part changes and delivers them to subscribers
+// The latest events are retained, so subscribers may resume after reconnecting
+// Every subscriber has a bounded buffer, a subscriber that lets it overflow is dropped
+// instead of blocking writers
+type Hub struct {
+	mu          sync.Mutex
+	revision    uint64                     // Revision of the latest event
+	history     []Event                    // Ring buffer of the latest events
+	next        int                        // Position of the next event in history
+	bufferSize  int                        // Events buffered per subscriber
+	subscribers map[*Subscription]struct{} // Active subscriptions
+	closed      bool
+}
+
+// NewHub creates a hub retaining historySize latest events
+// and buffering up to bufferSize events per subscriber
+func NewHub(historySize, bufferSize int) *Hub {
+	return &Hub{
+		history:     make([]Event, 0, historySize),
+		bufferSize:  bufferSize,
+		subscribers: make(map[*Subscription]struct{}),
+	}
+}
+
+// Publish assigns the next revision to the change and delivers it to subscribers
+// Callers must publish changes in the order they were applied
+func (h *Hub) Publish(eventType EventType, part model.Part, previous *model.Part) Event {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.revision++
+	event := Event{
+		Revision: h.revision,
+		Type:     eventType,
+		Part:     part,
+		Previous: previous,
+	}
+
+	// Retain the event, overwriting the oldest one once history is full
+	if len(h.history) < cap(h.history) {
+		h.history = append(h.history, event)
+	} else if len(h.history) > 0 {
+		h.history[h.next] = event
+		h.next = (h.next + 1) % len(h.history)
+	}
+
+	for sub := range h.subscribers {
+		select {
+		case sub.events <- event:
+		default:
+			h.drop(sub, fmt.Errorf("%w: buffer of %d events overflowed", ErrSlowConsumer, h.bufferSize))
+		}
+	}
+
+	return event
+}
+
+// Revision returns revision of the latest event
+func (h *Hub) Revision() uint64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.revision
+}
+
+// Subscribe starts delivering events following fromRevision
+// Zero revision subscribes to new events only
+// Returns error if events following the revision are no longer retained
+func (h *Hub) Subscribe(fromRevision uint64) (*Subscription, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return nil, ErrClosed
+	}
+	if fromRevision > h.revision {
+		return nil, fmt.Errorf("%w: %d > %d", ErrFutureRevision, fromRevision, h.revision)
+	}
+
+	// Retained events following the revision, oldest first
+	var backlog []Event
+	if fromRevision > 0 && fromRevision < h.revision {
+		retained := append(h.history[h.next:len(h.history):len(h.history)], h.history[:h.next]...)
+		if len(retained) == 0 || retained[0].Revision > fromRevision+1 {
+			return nil, fmt.Errorf("%w: %d", ErrRevisionCompacted, fromRevision)
+		}
+		backlog = retained[fromRevision+1-retained[0].Revision:]
+	}
+
+	sub := &Subscription{
+		hub:    h,
+		events: make(chan Event, len(backlog)+h.bufferSize),
+		done:   make(chan struct{}),
+	}
+	for _, event := range backlog {
+		sub.events <- event
+	}
+	h.subscribers[sub] = struct{}{}
+
+	return sub, nil
+}
+
+// Close ends all subscriptions with ErrClosed and rejects new ones
+func (h *Hub) Close() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for sub := range h.subscribers {
+		h.drop(sub, ErrClosed)
+	}
+
+	return nil
+}
+
+// drop ends the subscription with the error
+// Caller must hold the lock
+func (h *Hub) drop(sub *Subscription, err error) {
+	if _, ok := h.subscribers[sub]; !ok {
+		return
+	}
+
+	delete(h.subscribers, sub)
+	sub.err = err
+	close(sub.done)
+}
+
+// Subscription is a stream of events of the hub
+type Subscription struct {
+	hub    *Hub
+	events chan Event
+	done   chan struct{}
+	err    error // Set before done is closed
+}
+
+// Events returns channel of events in revision order
+// Events buffered before the subscription ended remain readable
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Done is closed when the hub ends the subscription, see Err for the reason
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err returns reason the subscription was ended by the hub
+func (s *Subscription) Err() error {
+	select {
+	case <-s.done:
+		return s.err
+	default:
+		return nil
+	}
+}
+
+// Close unsubscribes from the hub
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	s.hub.drop(s, nil)
+}
@@ -0,0 +1,76 @@
+package export
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/andredubov/rocket-factory/order/internal/repository/model"
+)
+
+// Query parameters of the export
+const (
+	paramStatus        = "status"
+	paramUserUUID      = "user_uuid"
+	paramPaymentMethod = "payment_method"
+	paramCreatedFrom   = "created_from"
+	paramCreatedTo     = "created_to"
+)
+
+// parseFilter builds order filter from query parameters.
+// Dates are expected in RFC 3339 format, created_to is exclusive.
+func parseFilter(query url.Values) (model.OrderFilter, error) {
+	var filter model.OrderFilter
+
+	if value := query.Get(paramStatus); value != "" {
+		filter.Status = model.OrderStatus(value)
+		if !filter.Status.IsValid() {
+			return filter, fmt.Errorf("invalid %s: %s", paramStatus, value)
+		}
+	}
+
+	if value := query.Get(paramUserUUID); value != "" {
+		userUUID, err := uuid.Parse(value)
+		if err != nil {
+			return filter, fmt.Errorf("invalid %s: %w", paramUserUUID, err)
+		}
+		filter.UserUUID = userUUID
+	}
+
+	if value := query.Get(paramPaymentMethod); value != "" {
+		filter.PaymentMethod = model.PaymentMethod(value)
+		if !filter.PaymentMethod.IsValid() {
+			return filter, fmt.Errorf("invalid %s: %s", paramPaymentMethod, value)
+		}
+	}
+
+	var err error
+	if filter.CreatedFrom, err = parseTime(query, paramCreatedFrom); err != nil {
+		return filter, err
+	}
+	if filter.CreatedTo, err = parseTime(query, paramCreatedTo); err != nil {
+		return filter, err
+	}
+	if !filter.CreatedFrom.IsZero() && !filter.CreatedTo.IsZero() && !filter.CreatedFrom.Before(filter.CreatedTo) {
+		return filter, fmt.Errorf("%s must be before %s", paramCreatedFrom, paramCreatedTo)
+	}
+
+	return filter, nil
+}
+
+// parseTime parses optional RFC 3339 query parameter
+func parseTime(query url.Values, name string) (time.Time, error) {
+	value := query.Get(name)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s, RFC 3339 expected: %w", name, err)
+	}
+
+	return t, nil
+}
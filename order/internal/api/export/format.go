@@ -0,0 +1,215 @@
+package export
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"mime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/andredubov/rocket-factory/order/internal/repository/model"
+)
+
+// encoder writes exported orders in a particular format
+type encoder interface {
+	writeHeader() error
+	write(order model.Order) error
+	flush() error
+}
+
+// format describes a supported export format
+type format struct {
+	contentType string
+	extension   string
+	newEncoder  func(w io.Writer) encoder
+}
+
+var (
+	formatCSV = format{
+		contentType: "text/csv; charset=utf-8",
+		extension:   ".csv",
+		newEncoder:  newCSVEncoder,
+	}
+	formatJSONLines = format{
+		contentType: "application/x-ndjson",
+		extension:   ".jsonl",
+		newEncoder:  newJSONLinesEncoder,
+	}
+)
+
+// mediaTypes maps accepted media types to export formats
+var mediaTypes = map[string]format{
+	"text/csv":             formatCSV,
+	"application/x-ndjson": formatJSONLines,
+	"application/jsonl":    formatJSONLines,
+}
+
+// negotiateFormat picks the first supported format listed in the Accept header.
+// CSV is used when the header is empty or accepts any type.
+func negotiateFormat(accept string) (format, bool) {
+	if strings.TrimSpace(accept) == "" {
+		return formatCSV, true
+	}
+
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		if f, ok := mediaTypes[mediaType]; ok {
+			return f, true
+		}
+		if mediaType == "*/*" || mediaType == "text/*" {
+			return formatCSV, true
+		}
+	}
+
+	return format{}, false
+}
+
+// supportedMediaTypes lists media types accepted by the export
+func supportedMediaTypes() []string {
+	return []string{"text/csv", "application/x-ndjson", "application/jsonl"}
+}
+
+// record is an exported order, fields are shared by both formats
+type record struct {
+	OrderUUID       string   `json:"order_uuid"`
+	UserUUID        string   `json:"user_uuid"`
+	Status          string   `json:"status"`
+	PartUUIDs       []string `json:"part_uuids"`
+	SubtotalPrice   float64  `json:"subtotal_price"`
+	PromoCode       string   `json:"promo_code,omitempty"`
+	DiscountAmount  float64  `json:"discount_amount"`
+	TotalPrice      float64  `json:"total_price"`
+	PaymentMethod   string   `json:"payment_method,omitempty"`
+	TransactionUUID string   `json:"transaction_uuid,omitempty"`
+	PriceBasis      string   `json:"price_basis,omitempty"`
+	CreatedAt       string   `json:"created_at"`
+	UpdatedAt       string   `json:"updated_at"`
+	PaidAt          string   `json:"paid_at,omitempty"`
+	CancelledAt     string   `json:"cancelled_at,omitempty"`
+}
+
+// csvColumns are columns of the CSV export in order of the record fields
+var csvColumns = []string{
+	"order_uuid", "user_uuid", "status", "part_uuids",
+	"subtotal_price", "promo_code", "discount_amount", "total_price",
+	"payment_method", "transaction_uuid", "price_basis",
+	"created_at", "updated_at", "paid_at", "cancelled_at",
+}
+
+// newRecord converts order to exported record
+func newRecord(order model.Order) record {
+	rec := record{
+		OrderUUID:     order.OrderUUID.String(),
+		UserUUID:      order.UserUUID.String(),
+		Status:        string(order.Status),
+		PartUUIDs:     make([]string, 0, len(order.PartUUIDs)),
+		SubtotalPrice: order.SubtotalPrice,
+		TotalPrice:    order.TotalPrice,
+		CreatedAt:     formatTime(&order.CreatedAt),
+		UpdatedAt:     formatTime(&order.UpdatedAt),
+		PaidAt:        formatTime(order.PaidAt),
+		CancelledAt:   formatTime(order.CancelledAt),
+	}
+
+	for _, partUUID := range order.PartUUIDs {
+		rec.PartUUIDs = append(rec.PartUUIDs, partUUID.String())
+	}
+	if order.Discount != nil {
+		rec.PromoCode = order.Discount.PromoCode
+		rec.DiscountAmount = order.Discount.Amount
+	}
+	if order.PaymentInfo != nil {
+		rec.PaymentMethod = string(order.PaymentInfo.PaymentMethod)
+		rec.PriceBasis = string(order.PaymentInfo.PriceBasis)
+		if order.PaymentInfo.TransactionUUID != uuid.Nil {
+			rec.TransactionUUID = order.PaymentInfo.TransactionUUID.String()
+		}
+	}
+
+	return rec
+}
+
+// formatTime formats optional time in RFC 3339 with UTC offset
+func formatTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
+
+// csvEncoder writes orders as CSV with a header row, part UUIDs are separated by semicolons
+type csvEncoder struct {
+	w *csv.Writer
+}
+
+func newCSVEncoder(w io.Writer) encoder {
+	return &csvEncoder{w: csv.NewWriter(w)}
+}
+
+func (e *csvEncoder) writeHeader() error {
+	return e.w.Write(csvColumns)
+}
+
+func (e *csvEncoder) write(order model.Order) error {
+	rec := newRecord(order)
+
+	return e.w.Write([]string{
+		rec.OrderUUID,
+		rec.UserUUID,
+		rec.Status,
+		strings.Join(rec.PartUUIDs, ";"),
+		formatPrice(rec.SubtotalPrice),
+		rec.PromoCode,
+		formatPrice(rec.DiscountAmount),
+		formatPrice(rec.TotalPrice),
+		rec.PaymentMethod,
+		rec.TransactionUUID,
+		rec.PriceBasis,
+		rec.CreatedAt,
+		rec.UpdatedAt,
+		rec.PaidAt,
+		rec.CancelledAt,
+	})
+}
+
+func (e *csvEncoder) flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// formatPrice formats price with 2 decimal places
+func formatPrice(price float64) string {
+	return strconv.FormatFloat(price, 'f', 2, 64)
+}
+
+// jsonLinesEncoder writes every order as a JSON object on its own line
+type jsonLinesEncoder struct {
+	buf *bufio.Writer
+	enc *json.Encoder
+}
+
+func newJSONLinesEncoder(w io.Writer) encoder {
+	buf := bufio.NewWriter(w)
+	return &jsonLinesEncoder{buf: buf, enc: json.NewEncoder(buf)}
+}
+
+func (e *jsonLinesEncoder) writeHeader() error {
+	return nil
+}
+
+func (e *jsonLinesEncoder) write(order model.Order) error {
+	return e.enc.Encode(newRecord(order))
+}
+
+func (e *jsonLinesEncoder) flush() error {
+	return e.buf.Flush()
+}
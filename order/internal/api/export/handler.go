@@ -0,0 +1,119 @@
+package export
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/andredubov/rocket-factory/order/internal/repository"
+	"github.com/andredubov/rocket-factory/order/internal/repository/model"
+)
+
+// flushEvery is the number of orders written between flushes to the client
+const flushEvery = 1000
+
+// Handler streams orders matching query filters as CSV or JSON Lines.
+// The format is chosen by the Accept header, requests must carry a bearer token.
+type Handler struct {
+	ordersRepository repository.Orders
+	token            string
+}
+
+// errorResponse has the same shape as errors of the order API
+type errorResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// NewHandler creates export handler protected by the given bearer token.
+func NewHandler(ordersRepository repository.Orders, token string) *Handler {
+	return &Handler{
+		ordersRepository: ordersRepository,
+		token:            token,
+	}
+}
+
+// ServeHTTP authenticates the request, parses filters and streams matching orders.
+// Orders are encoded one by one and flushed periodically, so memory usage
+// doesn't depend on the number of exported orders.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !h.authenticated(r) {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="orders export"`)
+		writeError(w, http.StatusUnauthorized, "valid bearer token required")
+		return
+	}
+
+	filter, err := parseFilter(r.URL.Query())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	format, ok := negotiateFormat(r.Header.Get("Accept"))
+	if !ok {
+		writeError(w, http.StatusNotAcceptable, "supported formats: "+strings.Join(supportedMediaTypes(), ", "))
+		return
+	}
+
+	w.Header().Set("Content-Type", format.contentType)
+	w.Header().Set("Content-Disposition", `attachment; filename="orders`+format.extension+`"`)
+	w.Header().Set("Cache-Control", "no-store")
+
+	enc := format.newEncoder(w)
+	flusher, _ := w.(http.Flusher)
+
+	count := 0
+	err = enc.writeHeader()
+	if err == nil {
+		err = h.ordersRepository.ForEachOrder(r.Context(), filter, func(order model.Order) error {
+			if err := enc.write(order); err != nil {
+				return err
+			}
+
+			count++
+			if count%flushEvery == 0 {
+				if err := enc.flush(); err != nil {
+					return err
+				}
+				if flusher != nil {
+					flusher.Flush()
+				}
+			}
+
+			return nil
+		})
+	}
+	if err == nil {
+		err = enc.flush()
+	}
+
+	// Status and part of the body are already sent, the error can only be logged
+	if err != nil {
+		slog.ErrorContext(r.Context(), "orders export interrupted", "exported", count, "error", err)
+		return
+	}
+
+	slog.InfoContext(r.Context(), "orders exported", "format", format.extension, "exported", count)
+}
+
+// authenticated checks bearer token of the request in constant time
+func (h *Handler) authenticated(r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || h.token == "" {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(token), []byte(h.token)) == 1
+}
+
+// writeError writes JSON error response
+func writeError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	if err := json.NewEncoder(w).Encode(errorResponse{Code: code, Message: message}); err != nil {
+		slog.Error("failed to write export error response", "error", err)
+	}
+}
@@ -0,0 +1,124 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// checkTimeout bounds a single readiness probe of a dependency
+const checkTimeout = 2 * time.Second
+
+// Check reports whether a dependency is able to serve requests.
+type Check func(ctx context.Context) error
+
+// Handler serves liveness and readiness probes.
+type Handler struct {
+	mu       sync.RWMutex
+	checks   map[string]Check
+	draining atomic.Bool
+}
+
+// response is the body returned by both probes.
+type response struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// Probe statuses
+const (
+	statusOK       = "ok"
+	statusFail     = "fail"
+	statusDraining = "draining"
+)
+
+// NewHandler creates handler without any readiness checks.
+func NewHandler() *Handler {
+	return &Handler{
+		checks: make(map[string]Check),
+	}
+}
+
+// AddCheck registers a named dependency check used by readiness probe.
+func (h *Handler) AddCheck(name string, check Check) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.checks[name] = check
+}
+
+// SetDraining makes readiness probe fail so that no new traffic is routed
+// to the service while it is shutting down.
+func (h *Handler) SetDraining() {
+	h.draining.Store(true)
+}
+
+// Liveness answers 200 as long as the process is able to handle HTTP requests.
+func (h *Handler) Liveness(w http.ResponseWriter, _ *http.Request) {
+	writeResponse(w, http.StatusOK, response{Status: statusOK})
+}
+
+// Readiness runs all registered checks concurrently and answers 200 only if every check passed.
+func (h *Handler) Readiness(w http.ResponseWriter, r *http.Request) {
+	if h.draining.Load() {
+		writeResponse(w, http.StatusServiceUnavailable, response{Status: statusDraining})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), checkTimeout)
+	defer cancel()
+
+	h.mu.RLock()
+	checks := make(map[string]Check, len(h.checks))
+	for name, check := range h.checks {
+		checks[name] = check
+	}
+	h.mu.RUnlock()
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		results = make(map[string]string, len(checks))
+		ready   = true
+	)
+	for name, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			result := statusOK
+			if err := check(ctx); err != nil {
+				slog.WarnContext(ctx, "readiness check failed", "check", name, "error", err)
+				result = statusFail
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			results[name] = result
+			if result != statusOK {
+				ready = false
+			}
+		}()
+	}
+	wg.Wait()
+
+	if !ready {
+		writeResponse(w, http.StatusServiceUnavailable, response{Status: statusFail, Checks: results})
+		return
+	}
+
+	writeResponse(w, http.StatusOK, response{Status: statusOK, Checks: results})
+}
+
+// writeResponse encodes probe response as JSON.
+func writeResponse(w http.ResponseWriter, code int, body response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		slog.Error("failed to write probe response", "error", err)
+	}
+}
@@ -41,19 +41,39 @@ func (i *OrderImplementation) CancelOrder(ctx context.Context, params order_v1.C
 		}, nil
 	}
 
+	// Заказ, оплата которого началась, отменить нельзя
+	if order.PaymentStartedAt != nil {
+		return newPaymentInProgressError(), nil
+	}
+
 	// Меняем статус на Cancelled для заказов в статусе Pending
+	now := i.clock.Now()
 	order.Status = model.OrderStatusCancelled
+	order.CancelledAt = &now
+	order.UpdatedAt = now
 
-	// Обновляем заказ в репозитории
-	if err := i.ordersRepository.UpdateOrder(ctx, *order); err != nil {
-		if errors.Is(err, repository.ErrOrderNotFound) {
+	// Обновляем заказ, только если его не оплатили, не изменили и не отменили после чтения
+	if err := i.ordersRepository.UpdateOrderIf(ctx, *order, order.Version); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrOrderNotFound):
 			return &order_v1.NotFoundError{
 				Code:    http.StatusNotFound,
 				Message: "order not found",
 			}, nil
+		case errors.Is(err, repository.ErrOrderModified):
+			return &order_v1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: "order was changed by another request, retry the cancellation",
+			}, nil
 		}
 		return nil, fmt.Errorf("failed to update order: %w", err)
 	}
 
+	// Возврат использования промокода
+	i.releasePromoCode(ctx, *order)
+
+	// Учет бизнес-метрик
+	i.metrics.OrderCancelled(*order)
+
 	return &order_v1.CancelOrderNoContent{}, nil
 }
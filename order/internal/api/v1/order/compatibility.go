@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	order_v1 "github.com/andredubov/rocket-factory/shared/pkg/openapi/order/v1"
+	inventory_v1 "github.com/andredubov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+// validateCompatibility проверяет через InventoryService, что из деталей заказа можно собрать корабль.
+// Возвращает ответ 422 со списком нарушенных правил совместимости
+// или ответ с ошибкой, если деталь не найдена или InventoryService недоступен.
+func (i *OrderImplementation) validateCompatibility(ctx context.Context, partUUIDs []uuid.UUID) order_v1.CreateOrderRes {
+	uuids := make([]string, 0, len(partUUIDs))
+	for _, partUUID := range partUUIDs {
+		uuids = append(uuids, partUUID.String())
+	}
+
+	inventoryResponse, err := i.inventoryClient.ValidateConfiguration(ctx, &inventory_v1.ValidateConfigurationRequest{PartUuids: uuids})
+	if err != nil {
+		switch {
+		case isServiceUnavailable(err):
+			return newServiceUnavailableError("inventory")
+		case status.Code(err) == codes.NotFound, status.Code(err) == codes.InvalidArgument:
+			return &order_v1.BadRequestError{
+				Code:    http.StatusBadRequest,
+				Message: fmt.Sprintf("invalid parts: %s", status.Convert(err).Message()),
+			}
+		default:
+			return newBadGatewayError("inventory", err)
+		}
+	}
+
+	if inventoryResponse.GetValid() {
+		return nil
+	}
+
+	violations := make([]order_v1.RuleViolation, 0, len(inventoryResponse.GetViolations()))
+	for _, violation := range inventoryResponse.GetViolations() {
+		violations = append(violations, convertToRuleViolation(violation))
+	}
+
+	slog.InfoContext(ctx, "order parts are incompatible", "violations", len(violations))
+
+	return &order_v1.ValidationError{
+		Code:       http.StatusUnprocessableEntity,
+		Message:    "order parts are incompatible",
+		Violations: violations,
+	}
+}
+
+// convertToRuleViolation преобразует нарушение правила совместимости из ответа InventoryService.
+// UUID деталей, которые не удалось разобрать, пропускаются.
+func convertToRuleViolation(violation *inventory_v1.RuleViolation) order_v1.RuleViolation {
+	rule := violation.GetRule()
+	res := order_v1.RuleViolation{
+		RuleID:    rule.GetId(),
+		Kind:      order_v1.RuleKind(strings.TrimPrefix(rule.GetKind().String(), "RULE_KIND_")),
+		Message:   violation.GetMessage(),
+		PartUuids: make([]uuid.UUID, 0, len(violation.GetPartUuids())),
+	}
+	if rule.GetDescription() != "" {
+		res.Description = order_v1.NewOptString(rule.GetDescription())
+	}
+
+	for _, raw := range violation.GetPartUuids() {
+		if partUUID, err := uuid.Parse(raw); err == nil {
+			res.PartUuids = append(res.PartUuids, partUUID)
+		}
+	}
+
+	return res
+}
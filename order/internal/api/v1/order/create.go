@@ -8,7 +8,10 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/andredubov/rocket-factory/order/internal/discount"
 	"github.com/andredubov/rocket-factory/order/internal/repository"
 	"github.com/andredubov/rocket-factory/order/internal/repository/model"
 	order_v1 "github.com/andredubov/rocket-factory/shared/pkg/openapi/order/v1"
@@ -25,32 +28,87 @@ func (i *OrderImplementation) CreateOrder(ctx context.Context, req *order_v1.Cre
 		}, nil
 	}
 
+	now := i.clock.Now()
 	order := model.Order{
 		UserUUID:  req.GetUserUUID(),
 		PartUUIDs: req.GetPartUuids(),
 		Status:    model.OrderStatusPending,
 		OrderUUID: uuid.New(),
+		CreatedAt: now,
+		UpdatedAt: now,
 	}
 
-	// Проверка наличия деталей и расчет стоимости
-	total := decimal.NewFromFloat(0)
-	for _, partUuid := range order.PartUUIDs {
-		inventoryRequest := inventory_v1.GetPartRequest{Uuid: partUuid.String()}
-		inventoryResponse, err := i.inventoryClient.GetPart(ctx, &inventoryRequest)
+	promoCode, hasPromoCode := req.GetPromoCode().Get()
+
+	// Расчет стоимости по ценам из расчета либо по текущим ценам склада
+	var items []discount.Item
+	quoteUUID, hasQuote := req.GetQuoteUUID().Get()
+	if hasQuote {
+		quote, badRequest, err := i.getQuoteForOrder(ctx, quoteUUID, order)
 		if err != nil {
-			return &order_v1.BadRequestError{
-				Code:    http.StatusBadRequest,
-				Message: fmt.Sprintf("invalid part %s: %v", partUuid, err),
-			}, nil
+			return nil, err
+		}
+		if badRequest != nil {
+			return badRequest, nil
 		}
 
-		total = total.Add(decimal.NewFromFloat(inventoryResponse.GetPart().GetPrice()))
+		items = quoteDiscountItems(quote.Items)
+		if !hasPromoCode && quote.Discount != nil {
+			promoCode, hasPromoCode = quote.Discount.PromoCode, true
+		}
+	} else {
+		var res partsErrorRes
+		items, res = i.priceParts(ctx, order.PartUUIDs)
+		if res != nil {
+			return res, nil
+		}
+	}
+
+	// Проверка совместимости деталей до резервирования промокода
+	if req.GetValidateCompatibility().Or(false) {
+		if res := i.validateCompatibility(ctx, order.PartUUIDs); res != nil {
+			return res, nil
+		}
+	}
+
+	subtotal := discount.Subtotal(items)
+	order.PartPrices = partPrices(items)
+	order.SubtotalPrice, _ = subtotal.Round(2).Float64()
+	total := subtotal
+
+	// Применение скидки по промокоду
+	if hasPromoCode {
+		badRequest, err := i.applyPromoCode(ctx, &order, promoCode, items)
+		if err != nil {
+			return nil, err
+		}
+		if badRequest != nil {
+			return badRequest, nil
+		}
+		total = total.Sub(decimal.NewFromFloat(order.Discount.Amount))
 	}
 
 	order.TotalPrice, _ = total.Round(2).Float64()
 
+	// Закрепление расчета за заказом
+	if hasQuote {
+		badRequest, err := i.useQuote(ctx, quoteUUID, order.OrderUUID)
+		if err != nil {
+			i.releasePromoCode(ctx, order)
+			return nil, err
+		}
+		if badRequest != nil {
+			i.releasePromoCode(ctx, order)
+			return badRequest, nil
+		}
+	}
+
 	// Сохранение
 	if err := i.ordersRepository.AddOrder(ctx, order); err != nil {
+		i.releasePromoCode(ctx, order)
+		if hasQuote {
+			i.releaseQuote(ctx, quoteUUID, order.OrderUUID)
+		}
 		if errors.Is(err, repository.ErrOrderAlreadyExists) {
 			return &order_v1.ConflictError{
 				Code:    http.StatusConflict,
@@ -60,10 +118,65 @@ func (i *OrderImplementation) CreateOrder(ctx context.Context, req *order_v1.Cre
 		return nil, fmt.Errorf("failed to save order: %w", err)
 	}
 
+	// Учет бизнес-метрик
+	i.metrics.OrderCreated(order)
+
 	res := &order_v1.CreateOrderResponse{
-		OrderUUID:  order_v1.NewOptUUID(order.OrderUUID),
-		TotalPrice: order_v1.NewOptFloat64(order.TotalPrice),
+		OrderUUID:     order_v1.NewOptUUID(order.OrderUUID),
+		TotalPrice:    order_v1.NewOptFloat64(order.TotalPrice),
+		SubtotalPrice: order_v1.NewOptFloat64(order.SubtotalPrice),
+		Discount:      convertToAppliedDiscount(order.Discount),
+		CreatedAt:     order_v1.NewOptDateTime(order.CreatedAt),
 	}
 
 	return res, nil
 }
+
+// partsErrorRes объединяет ответы с ошибками проверки деталей,
+// общие для создания и изменения заказа.
+type partsErrorRes interface {
+	order_v1.CreateOrderRes
+	order_v1.UpdateOrderRes
+}
+
+// priceParts проверяет наличие деталей через InventoryService и возвращает их текущие цены.
+// Возвращает ответ с ошибкой, если деталь не найдена или InventoryService недоступен.
+func (i *OrderImplementation) priceParts(ctx context.Context, partUUIDs []uuid.UUID) ([]discount.Item, partsErrorRes) {
+	items := make([]discount.Item, 0, len(partUUIDs))
+	for _, partUuid := range partUUIDs {
+		inventoryRequest := inventory_v1.GetPartRequest{Uuid: partUuid.String()}
+		inventoryResponse, err := i.inventoryClient.GetPart(ctx, &inventoryRequest)
+		if err != nil {
+			switch {
+			case isServiceUnavailable(err):
+				return nil, newServiceUnavailableError("inventory")
+			case status.Code(err) == codes.NotFound, status.Code(err) == codes.InvalidArgument:
+				return nil, &order_v1.BadRequestError{
+					Code:    http.StatusBadRequest,
+					Message: fmt.Sprintf("invalid part %s: %s", partUuid, status.Convert(err).Message()),
+				}
+			default:
+				return nil, newBadGatewayError("inventory", err)
+			}
+		}
+
+		part := inventoryResponse.GetPart()
+		items = append(items, discount.Item{
+			PartUUID: partUuid,
+			Category: partCategory(part.GetCategory()),
+			Price:    decimal.NewFromFloat(part.GetPrice()),
+		})
+	}
+
+	return items, nil
+}
+
+// partPrices возвращает цены деталей заказа по их UUID.
+func partPrices(items []discount.Item) map[uuid.UUID]float64 {
+	prices := make(map[uuid.UUID]float64, len(items))
+	for _, item := range items {
+		prices[item.PartUUID] = item.Price.InexactFloat64()
+	}
+
+	return prices
+}
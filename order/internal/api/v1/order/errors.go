@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	order_v1 "github.com/andredubov/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+// isServiceUnavailable проверяет, что зависимость недоступна, перегружена
+// или не ответила вовремя (в том числе при открытом circuit breaker).
+func isServiceUnavailable(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
+
+// newServiceUnavailableError формирует ответ 503 для недоступной зависимости.
+func newServiceUnavailableError(service string) *order_v1.ServiceUnavailableError {
+	return &order_v1.ServiceUnavailableError{
+		Code:    http.StatusServiceUnavailable,
+		Message: fmt.Sprintf("%s service is unavailable", service),
+	}
+}
+
+// newBadGatewayError формирует ответ 502 для непредвиденной ошибки зависимости.
+func newBadGatewayError(service string, err error) *order_v1.BadGatewayError {
+	return &order_v1.BadGatewayError{
+		Code:    http.StatusBadGateway,
+		Message: fmt.Sprintf("%s service error: %s", service, status.Convert(err).Message()),
+	}
+}
+
+// newPaymentInProgressError формирует ответ 409 для заказа, оплата которого еще не завершена.
+func newPaymentInProgressError() *order_v1.ConflictError {
+	return &order_v1.ConflictError{
+		Code:    http.StatusConflict,
+		Message: "order payment is in progress",
+	}
+}
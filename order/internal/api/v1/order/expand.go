@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/andredubov/rocket-factory/order/internal/repository/model"
+	order_v1 "github.com/andredubov/rocket-factory/shared/pkg/openapi/order/v1"
+	inventory_v1 "github.com/andredubov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+// expandParts встраивает в ответ сведения о деталях заказа.
+// Все детали запрашиваются у InventoryService одним запросом. Если InventoryService
+// недоступен, ответ содержит только цены на момент заказа и признак parts_degraded.
+func (i *OrderImplementation) expandParts(ctx context.Context, order model.Order, res *order_v1.GetOrderResponse) {
+	uniqueUUIDs := uniquePartUUIDs(order.PartUUIDs)
+	parts, err := i.getParts(ctx, uniqueUUIDs)
+	if err != nil {
+		slog.WarnContext(ctx, "order parts are not expanded, inventory request failed",
+			"order_uuid", order.OrderUUID, "error", err)
+	}
+
+	requested := make(map[uuid.UUID]int64, len(uniqueUUIDs))
+	for _, partUUID := range order.PartUUIDs {
+		requested[partUUID]++
+	}
+
+	res.Parts = make([]order_v1.OrderPart, 0, len(order.PartUUIDs))
+	for _, partUUID := range order.PartUUIDs {
+		orderPart := order_v1.OrderPart{PartUUID: partUUID}
+		if price, ok := order.PartPrices[partUUID]; ok {
+			orderPart.UnitPrice = order_v1.NewOptFloat64(price)
+		}
+
+		// Без ответа InventoryService наличие детали неизвестно
+		if err == nil {
+			part, found := parts[partUUID]
+			if found {
+				orderPart.Name = order_v1.NewOptString(part.GetName())
+				orderPart.Category = order_v1.NewOptString(partCategory(part.GetCategory()))
+			}
+			orderPart.Available = order_v1.NewOptBool(found && part.GetStockQuantity() >= requested[partUUID])
+		}
+
+		res.Parts = append(res.Parts, orderPart)
+	}
+
+	res.PartsDegraded = order_v1.NewOptBool(err != nil)
+}
+
+// getParts запрашивает детали у InventoryService пакетным запросом вместо запроса на каждую деталь.
+// Не найденные детали отсутствуют в результате.
+func (i *OrderImplementation) getParts(ctx context.Context, partUUIDs []uuid.UUID) (map[uuid.UUID]*inventory_v1.Part, error) {
+	filter := &inventory_v1.PartsFilter{Uuids: make([]string, 0, len(partUUIDs))}
+	for _, partUUID := range partUUIDs {
+		filter.Uuids = append(filter.Uuids, partUUID.String())
+	}
+
+	// Детали запрашиваются постранично, пока InventoryService возвращает токен следующей страницы
+	parts := make(map[uuid.UUID]*inventory_v1.Part, len(partUUIDs))
+	inventoryRequest := &inventory_v1.ListPartsRequest{Filter: filter}
+	for {
+		inventoryResponse, err := i.inventoryClient.ListParts(ctx, inventoryRequest)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, part := range inventoryResponse.GetParts() {
+			partUUID, err := uuid.Parse(part.GetUuid())
+			if err != nil {
+				return nil, fmt.Errorf("invalid part uuid %q: %w", part.GetUuid(), err)
+			}
+			parts[partUUID] = part
+		}
+
+		if len(inventoryResponse.GetNextPageToken()) == 0 {
+			return parts, nil
+		}
+		inventoryRequest.PageToken = inventoryResponse.GetNextPageToken()
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 
 	"github.com/andredubov/rocket-factory/order/internal/repository"
 	"github.com/andredubov/rocket-factory/order/internal/repository/model"
@@ -32,13 +33,40 @@ func (i *OrderImplementation) GetOrderByUuid(ctx context.Context, params order_v
 		return nil, fmt.Errorf("repository error: %w", err)
 	}
 
+	res, err := convertToOrderResponse(*order)
+	if err != nil {
+		return nil, err
+	}
+
+	// Встраивание сведений о деталях по запросу
+	if slices.Contains(params.Expand, order_v1.ExpandItemParts) {
+		i.expandParts(ctx, *order, res)
+	}
+
+	return res, nil
+}
+
+// convertToOrderResponse конвертирует заказ в формат API.
+func convertToOrderResponse(order model.Order) (*order_v1.GetOrderResponse, error) {
 	// Создаем базовый ответ с обязательными полями заказа
 	res := &order_v1.GetOrderResponse{
-		OrderUUID:  order.OrderUUID,                    // UUID заказа
-		UserUUID:   order.UserUUID,                     // UUID пользователя
-		PartUuids:  order.PartUUIDs,                    // Список UUID деталей в заказе
-		TotalPrice: order.TotalPrice,                   // Общая стоимость заказа
-		Status:     order_v1.OrderStatus(order.Status), // Текущий статус заказа
+		OrderUUID:     order.OrderUUID,                          // UUID заказа
+		UserUUID:      order.UserUUID,                           // UUID пользователя
+		PartUuids:     order.PartUUIDs,                          // Список UUID деталей в заказе
+		SubtotalPrice: order.SubtotalPrice,                      // Стоимость до скидки
+		Discount:      convertToAppliedDiscount(order.Discount), // Примененная скидка
+		TotalPrice:    order.TotalPrice,                         // Общая стоимость заказа
+		Status:        order_v1.OrderStatus(order.Status),       // Текущий статус заказа
+		CreatedAt:     order.CreatedAt,                          // Время создания заказа
+		UpdatedAt:     order.UpdatedAt,                          // Время последнего изменения
+	}
+
+	// Время оплаты и отмены присутствует только после соответствующего перехода
+	if order.PaidAt != nil {
+		res.PaidAt = order_v1.NewOptDateTime(*order.PaidAt)
+	}
+	if order.CancelledAt != nil {
+		res.CancelledAt = order_v1.NewOptDateTime(*order.CancelledAt)
 	}
 
 	// Если есть информация о платеже, добавляем ее в ответ
@@ -50,6 +78,10 @@ func (i *OrderImplementation) GetOrderByUuid(ctx context.Context, params order_v
 			return nil, fmt.Errorf("payment method conversion error: %w", err)
 		}
 		res.PaymentMethod = order_v1.NewOptPaymentMethod(paymentMethod)
+
+		if order.PaymentInfo.PriceBasis != "" {
+			res.PriceBasis = order_v1.NewOptPriceBasis(order_v1.PriceBasis(order.PaymentInfo.PriceBasis))
+		}
 	}
 
 	return res, nil
@@ -1,6 +1,11 @@
 package handler
 
 import (
+	"time"
+
+	"github.com/andredubov/rocket-factory/order/internal/clock"
+	"github.com/andredubov/rocket-factory/order/internal/metrics"
+	"github.com/andredubov/rocket-factory/order/internal/pricing"
 	"github.com/andredubov/rocket-factory/order/internal/repository"
 	order_v1 "github.com/andredubov/rocket-factory/shared/pkg/openapi/order/v1"
 	inventory_v1 "github.com/andredubov/rocket-factory/shared/pkg/proto/inventory/v1"
@@ -10,20 +15,41 @@ import (
 // OrderImplementation реализует интерфейс обработчика заказов.
 type OrderImplementation struct {
 	order_v1.UnimplementedHandler
-	ordersRepository repository.Orders
-	paymentClient    payment_v1.PaymentServiceClient
-	inventoryClient  inventory_v1.InventoryServiceClient
+	ordersRepository    repository.Orders
+	promoCodes          repository.PromoCodes
+	quotes              repository.Quotes
+	quoteTTL            time.Duration
+	pricePolicy         pricing.Policy
+	priceDriftTolerance float64
+	paymentClient       payment_v1.PaymentServiceClient
+	inventoryClient     inventory_v1.InventoryServiceClient
+	metrics             *metrics.Orders
+	clock               clock.Clock
 }
 
 // NewOrderHandler создает новый экземпляр обработчика заказов.
 func NewOrderHandler(
 	repo repository.Orders,
+	promoCodes repository.PromoCodes,
+	quotes repository.Quotes,
+	quoteTTL time.Duration,
+	pricePolicy pricing.Policy,
+	priceDriftTolerance float64,
 	paymentClient payment_v1.PaymentServiceClient,
 	inventoryClient inventory_v1.InventoryServiceClient,
+	metrics *metrics.Orders,
+	clock clock.Clock,
 ) *OrderImplementation {
 	return &OrderImplementation{
-		ordersRepository: repo,
-		paymentClient:    paymentClient,
-		inventoryClient:  inventoryClient,
+		ordersRepository:    repo,
+		promoCodes:          promoCodes,
+		quotes:              quotes,
+		quoteTTL:            quoteTTL,
+		pricePolicy:         pricePolicy,
+		priceDriftTolerance: priceDriftTolerance,
+		paymentClient:       paymentClient,
+		inventoryClient:     inventoryClient,
+		metrics:             metrics,
+		clock:               clock,
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -35,6 +36,9 @@ func (i *OrderImplementation) PayOrder(ctx context.Context, req *order_v1.PayOrd
 			Message: "order is not in pending status",
 		}, nil
 	}
+	if order.PaymentStartedAt != nil {
+		return newPaymentInProgressError(), nil
+	}
 
 	// Валидация метода оплаты
 	if !model.PaymentMethod(req.PaymentMethod).IsValid() {
@@ -44,9 +48,43 @@ func (i *OrderImplementation) PayOrder(ctx context.Context, req *order_v1.PayOrd
 		}, nil
 	}
 
+	// Заказ до пересчета цен, восстанавливается при ошибке оплаты
+	unpaid := *order
+
+	// Проверка цен деталей по текущим ценам склада
+	priceBasis, res, err := i.revalidatePrices(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+	if res != nil {
+		return res, nil
+	}
+
+	// Закрепление заказа за оплатой: пока она не завершена, заказ нельзя изменить,
+	// отменить или оплатить повторно, поэтому списывается сумма именно этой версии заказа
+	startedAt := i.clock.Now()
+	order.PaymentStartedAt = &startedAt
+	if err := i.ordersRepository.UpdateOrderIf(ctx, *order, order.Version); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrOrderNotFound):
+			return &order_v1.NotFoundError{
+				Code:    http.StatusNotFound,
+				Message: "order not found",
+			}, nil
+		case errors.Is(err, repository.ErrOrderModified):
+			return &order_v1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: "order was changed by another request, retry the payment",
+			}, nil
+		}
+		return nil, fmt.Errorf("failed to start order payment: %w", err)
+	}
+	claimedVersion := order.Version + 1
+
 	// Подготовка платежной информации
 	order.PaymentInfo = &model.PaymentInfo{
 		PaymentMethod: model.PaymentMethod(req.PaymentMethod),
+		PriceBasis:    priceBasis,
 	}
 
 	// Создание запроса в платежный сервис
@@ -54,37 +92,63 @@ func (i *OrderImplementation) PayOrder(ctx context.Context, req *order_v1.PayOrd
 		OrderUuid:     order.OrderUUID.String(),
 		UserUuid:      order.UserUUID.String(),
 		PaymentMethod: ConvertModelPaymentMethodToProto(order.PaymentInfo.PaymentMethod),
+		Amount:        order.TotalPrice,
 	}
 
 	// Вызов платежного сервиса
 	paymentResponse, err := i.paymentClient.PayOrder(ctx, paymentRequest)
 	if err != nil {
-		return nil, fmt.Errorf("payment service error: %w", err)
+		i.releasePayment(ctx, unpaid, claimedVersion)
+		if isServiceUnavailable(err) {
+			return newServiceUnavailableError("payment"), nil
+		}
+		return newBadGatewayError("payment", err), nil
 	}
 
 	// Парсинг UUID транзакции
 	transactionUUID, err := uuid.Parse(paymentResponse.GetTransactionUuid())
 	if err != nil {
+		// Сумма уже списана, поэтому заказ остается закрепленным за оплатой
 		return nil, fmt.Errorf("invalid transaction uuid: %w", err)
 	}
 
 	// Обновление информации о заказе
+	now := i.clock.Now()
 	order.PaymentInfo.TransactionUUID = transactionUUID
 	order.Status = model.OrderStatusPaid
+	order.PaidAt = &now
+	order.PaymentStartedAt = nil
+	order.UpdatedAt = now
 
-	// Сохранение обновленного заказа
-	if err := i.ordersRepository.UpdateOrder(ctx, *order); err != nil {
+	// Сохранение обновленного заказа, закрепленного за этой оплатой
+	if err := i.ordersRepository.UpdateOrderIf(ctx, *order, claimedVersion); err != nil {
 		return nil, fmt.Errorf("failed to update order: %w", err)
 	}
 
+	// Учет бизнес-метрик
+	i.metrics.OrderPaid(*order)
+
 	// Формирование ответа
 	response := &order_v1.PayOrderResponse{
 		TransactionUUID: order_v1.NewOptUUID(transactionUUID),
+		TotalPrice:      order_v1.NewOptFloat64(order.TotalPrice),
+		PriceBasis:      order_v1.NewOptPriceBasis(order_v1.PriceBasis(priceBasis)),
 	}
 
 	return response, nil
 }
 
+// releasePayment снимает закрепление заказа за незавершенной оплатой и восстанавливает
+// заказ в том виде, в котором он был до пересчета цен.
+// Ошибка только логируется, так как ответ клиенту определяет ошибка оплаты.
+func (i *OrderImplementation) releasePayment(ctx context.Context, unpaid model.Order, claimedVersion uint64) {
+	unpaid.PaymentStartedAt = nil
+	if err := i.ordersRepository.UpdateOrderIf(context.WithoutCancel(ctx), unpaid, claimedVersion); err != nil {
+		slog.ErrorContext(ctx, "failed to release order after failed payment",
+			"order_uuid", unpaid.OrderUUID, "error", err)
+	}
+}
+
 // ConvertModelPaymentMethodToProto конвертирует model.PaymentMethod в payment_v1.PaymentMethod.
 func ConvertModelPaymentMethodToProto(method model.PaymentMethod) payment_v1.PaymentMethod {
 	switch method {
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/andredubov/rocket-factory/order/internal/discount"
+	"github.com/andredubov/rocket-factory/order/internal/repository"
+	"github.com/andredubov/rocket-factory/order/internal/repository/model"
+	order_v1 "github.com/andredubov/rocket-factory/shared/pkg/openapi/order/v1"
+	inventory_v1 "github.com/andredubov/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+// applyPromoCode проверяет промокод, рассчитывает скидку и закрепляет использование
+// промокода за заказом. Возвращает ответ 400, если промокод не может быть применен.
+func (i *OrderImplementation) applyPromoCode(ctx context.Context, order *model.Order, code string, items []discount.Item) (*order_v1.BadRequestError, error) {
+	orderDiscount, badRequest, err := i.calculateDiscount(ctx, code, items)
+	if err != nil || badRequest != nil {
+		return badRequest, err
+	}
+
+	// Проверка и учет лимитов использования
+	redemption := model.Redemption{
+		Code:      orderDiscount.PromoCode,
+		UserUUID:  order.UserUUID,
+		OrderUUID: order.OrderUUID,
+	}
+	if err := i.promoCodes.RedeemPromoCode(ctx, redemption); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrPromoCodeExhausted):
+			return newPromoCodeError(code, repository.ErrPromoCodeExhausted), nil
+		case errors.Is(err, repository.ErrPromoCodeUserLimit):
+			return newPromoCodeError(code, repository.ErrPromoCodeUserLimit), nil
+		}
+		return nil, fmt.Errorf("failed to redeem promo code: %w", err)
+	}
+
+	order.Discount = orderDiscount
+	return nil, nil
+}
+
+// calculateDiscount проверяет промокод и рассчитывает скидку без учета лимитов использования.
+// Возвращает ответ 400, если промокод не может быть применен.
+func (i *OrderImplementation) calculateDiscount(ctx context.Context, code string, items []discount.Item) (*model.Discount, *order_v1.BadRequestError, error) {
+	promo, err := i.promoCodes.GetPromoCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, repository.ErrPromoCodeNotFound) {
+			return nil, newPromoCodeError(code, repository.ErrPromoCodeNotFound), nil
+		}
+		return nil, nil, fmt.Errorf("failed to get promo code: %w", err)
+	}
+
+	// Проверка сроков действия, минимальной суммы и категорий деталей
+	amount, err := discount.Calculate(*promo, items, i.clock.Now())
+	if err != nil {
+		return nil, newPromoCodeError(promo.Code, err), nil
+	}
+
+	return &model.Discount{
+		PromoCode: promo.Code,
+		Amount:    amount.InexactFloat64(),
+	}, nil, nil
+}
+
+// recalculateDiscount пересчитывает скидку уже примененного к заказу промокода
+// для нового списка деталей. Срок действия промокода повторно не проверяется.
+// Возвращает ответ 400, если промокод больше не применим к заказу или был удален.
+func (i *OrderImplementation) recalculateDiscount(ctx context.Context, applied *model.Discount, items []discount.Item) (*model.Discount, *order_v1.BadRequestError, error) {
+	promo, err := i.promoCodes.GetPromoCode(ctx, applied.PromoCode)
+	if err != nil {
+		if errors.Is(err, repository.ErrPromoCodeNotFound) {
+			return nil, newPromoCodeError(applied.PromoCode, repository.ErrPromoCodeNotFound), nil
+		}
+		return nil, nil, fmt.Errorf("failed to get promo code: %w", err)
+	}
+
+	amount, err := discount.Amount(*promo, items)
+	if err != nil {
+		return nil, newPromoCodeError(promo.Code, err), nil
+	}
+
+	return &model.Discount{
+		PromoCode: promo.Code,
+		Amount:    amount.InexactFloat64(),
+	}, nil, nil
+}
+
+// releasePromoCode возвращает использование промокода, закрепленное за заказом.
+// Ошибка не прерывает обработку запроса, а только логируется.
+func (i *OrderImplementation) releasePromoCode(ctx context.Context, order model.Order) {
+	if order.Discount == nil {
+		return
+	}
+
+	redemption := model.Redemption{
+		Code:      order.Discount.PromoCode,
+		UserUUID:  order.UserUUID,
+		OrderUUID: order.OrderUUID,
+	}
+	if err := i.promoCodes.ReleasePromoCode(ctx, redemption); err != nil {
+		slog.WarnContext(ctx, "failed to release promo code",
+			"promo_code", redemption.Code, "order_uuid", order.OrderUUID, "error", err)
+	}
+}
+
+// newPromoCodeError формирует ответ 400 для промокода, который не может быть применен.
+func newPromoCodeError(code string, err error) *order_v1.BadRequestError {
+	return &order_v1.BadRequestError{
+		Code:    http.StatusBadRequest,
+		Message: fmt.Sprintf("promo code %s can't be applied: %s", model.NormalizePromoCode(code), err),
+	}
+}
+
+// convertToAppliedDiscount конвертирует примененную скидку в формат API.
+func convertToAppliedDiscount(d *model.Discount) order_v1.OptAppliedDiscount {
+	if d == nil {
+		return order_v1.OptAppliedDiscount{}
+	}
+
+	return order_v1.NewOptAppliedDiscount(order_v1.AppliedDiscount{
+		PromoCode: d.PromoCode,
+		Amount:    d.Amount,
+	})
+}
+
+// partCategory возвращает категорию детали без префикса CATEGORY_, например ENGINE.
+func partCategory(category inventory_v1.Category) string {
+	return strings.TrimPrefix(category.String(), "CATEGORY_")
+}
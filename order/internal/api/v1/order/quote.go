@@ -0,0 +1,230 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/andredubov/rocket-factory/order/internal/discount"
+	"github.com/andredubov/rocket-factory/order/internal/repository"
+	"github.com/andredubov/rocket-factory/order/internal/repository/model"
+	order_v1 "github.com/andredubov/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+// QuoteOrder рассчитывает стоимость заказа без его создания.
+// Цены из расчета сохраняются и соблюдаются при создании заказа до истечения расчета.
+func (i *OrderImplementation) QuoteOrder(ctx context.Context, req *order_v1.QuoteOrderRequest) (order_v1.QuoteOrderRes, error) {
+	// Валидация
+	if len(req.GetPartUuids()) == 0 {
+		return &order_v1.BadRequestError{
+			Code:    http.StatusBadRequest,
+			Message: "at least one part required",
+		}, nil
+	}
+
+	// Получение всех деталей одним запросом
+	uniqueUUIDs := uniquePartUUIDs(req.GetPartUuids())
+	parts, err := i.getParts(ctx, uniqueUUIDs)
+	if err != nil {
+		if isServiceUnavailable(err) {
+			return newServiceUnavailableError("inventory"), nil
+		}
+		return newBadGatewayError("inventory", err), nil
+	}
+
+	quote := model.Quote{
+		QuoteUUID: uuid.New(),
+		UserUUID:  req.GetUserUUID(),
+		PartUUIDs: req.GetPartUuids(),
+		ExpiresAt: i.clock.Now().Add(i.quoteTTL),
+	}
+
+	// Проверка наличия: деталь найдена и на складе хватает единиц на все позиции заказа
+	requested := make(map[uuid.UUID]int64, len(uniqueUUIDs))
+	for _, partUUID := range quote.PartUUIDs {
+		requested[partUUID]++
+	}
+
+	for _, partUUID := range uniqueUUIDs {
+		part, ok := parts[partUUID]
+		if !ok || part.GetStockQuantity() < requested[partUUID] {
+			quote.UnavailablePartUUIDs = append(quote.UnavailablePartUUIDs, partUUID)
+		}
+	}
+
+	for _, partUUID := range quote.PartUUIDs {
+		if slices.Contains(quote.UnavailablePartUUIDs, partUUID) {
+			continue
+		}
+
+		part := parts[partUUID]
+		quote.Items = append(quote.Items, model.QuoteItem{
+			PartUUID: partUUID,
+			Name:     part.GetName(),
+			Category: partCategory(part.GetCategory()),
+			Price:    part.GetPrice(),
+		})
+	}
+
+	// Расчет стоимости доступных деталей и скидки
+	items := quoteDiscountItems(quote.Items)
+	subtotal := discount.Subtotal(items)
+	total := subtotal
+
+	if code, ok := req.GetPromoCode().Get(); ok && len(items) > 0 {
+		quoteDiscount, badRequest, err := i.calculateDiscount(ctx, code, items)
+		if err != nil {
+			return nil, err
+		}
+		if badRequest != nil {
+			return badRequest, nil
+		}
+
+		quote.Discount = quoteDiscount
+		total = total.Sub(decimal.NewFromFloat(quoteDiscount.Amount))
+	}
+
+	quote.SubtotalPrice, _ = subtotal.Round(2).Float64()
+	quote.TotalPrice, _ = total.Round(2).Float64()
+
+	// Сохранение
+	if err := i.quotes.AddQuote(ctx, quote); err != nil {
+		return nil, fmt.Errorf("failed to save quote: %w", err)
+	}
+
+	return convertToQuoteResponse(quote), nil
+}
+
+// getQuoteForOrder возвращает расчет, по которому создается заказ.
+// Возвращает ответ 400, если расчет не найден, истек, уже использован,
+// не совпадает с заказом или содержит недоступные детали.
+func (i *OrderImplementation) getQuoteForOrder(ctx context.Context, quoteUUID uuid.UUID, order model.Order) (*model.Quote, *order_v1.BadRequestError, error) {
+	quote, err := i.quotes.GetQuote(ctx, quoteUUID)
+	if err != nil {
+		if errors.Is(err, repository.ErrQuoteNotFound) {
+			return nil, newQuoteError(quoteUUID, "quote not found"), nil
+		}
+		return nil, nil, fmt.Errorf("failed to get quote: %w", err)
+	}
+
+	switch {
+	case quote.IsExpired(i.clock.Now()):
+		return nil, newQuoteError(quoteUUID, "quote has expired"), nil
+	case quote.OrderUUID != uuid.Nil:
+		return nil, newQuoteError(quoteUUID, "quote has already been used"), nil
+	case quote.UserUUID != order.UserUUID || !samePartUUIDs(quote.PartUUIDs, order.PartUUIDs):
+		return nil, newQuoteError(quoteUUID, "quote doesn't match the order"), nil
+	case len(quote.UnavailablePartUUIDs) > 0:
+		return nil, newQuoteError(quoteUUID, "quote contains unavailable parts"), nil
+	}
+
+	return quote, nil, nil
+}
+
+// useQuote закрепляет расчет за заказом, чтобы по одному расчету нельзя было создать несколько заказов.
+// Возвращает ответ 400, если расчет уже использован другим заказом или удален.
+func (i *OrderImplementation) useQuote(ctx context.Context, quoteUUID, orderUUID uuid.UUID) (*order_v1.BadRequestError, error) {
+	if err := i.quotes.UseQuote(ctx, quoteUUID, orderUUID); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrQuoteUsed):
+			return newQuoteError(quoteUUID, "quote has already been used"), nil
+		case errors.Is(err, repository.ErrQuoteNotFound):
+			return newQuoteError(quoteUUID, "quote not found"), nil
+		}
+		return nil, fmt.Errorf("failed to use quote: %w", err)
+	}
+
+	return nil, nil
+}
+
+// releaseQuote возвращает расчет, закрепленный за несохраненным заказом.
+// Ошибка только логируется, так как ответ клиенту определяет ошибка сохранения заказа.
+func (i *OrderImplementation) releaseQuote(ctx context.Context, quoteUUID, orderUUID uuid.UUID) {
+	if err := i.quotes.ReleaseQuote(ctx, quoteUUID, orderUUID); err != nil {
+		slog.WarnContext(ctx, "failed to release quote",
+			"quote_uuid", quoteUUID, "order_uuid", orderUUID, "error", err)
+	}
+}
+
+// newQuoteError формирует ответ 400 для расчета, по которому нельзя создать заказ.
+func newQuoteError(quoteUUID uuid.UUID, reason string) *order_v1.BadRequestError {
+	return &order_v1.BadRequestError{
+		Code:    http.StatusBadRequest,
+		Message: fmt.Sprintf("quote %s can't be used: %s", quoteUUID, reason),
+	}
+}
+
+// quoteDiscountItems конвертирует позиции расчета в позиции для расчета скидки.
+func quoteDiscountItems(quoteItems []model.QuoteItem) []discount.Item {
+	items := make([]discount.Item, 0, len(quoteItems))
+	for _, item := range quoteItems {
+		items = append(items, discount.Item{
+			PartUUID: item.PartUUID,
+			Category: item.Category,
+			Price:    decimal.NewFromFloat(item.Price),
+		})
+	}
+
+	return items
+}
+
+// uniquePartUUIDs возвращает UUID деталей без повторов в порядке их первого указания.
+func uniquePartUUIDs(partUUIDs []uuid.UUID) []uuid.UUID {
+	unique := make([]uuid.UUID, 0, len(partUUIDs))
+	for _, partUUID := range partUUIDs {
+		if !slices.Contains(unique, partUUID) {
+			unique = append(unique, partUUID)
+		}
+	}
+
+	return unique
+}
+
+// samePartUUIDs проверяет, что списки содержат одни и те же детали в одинаковом количестве.
+func samePartUUIDs(a, b []uuid.UUID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	compare := func(x, y uuid.UUID) int { return bytes.Compare(x[:], y[:]) }
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.SortFunc(a, compare)
+	slices.SortFunc(b, compare)
+
+	return slices.Equal(a, b)
+}
+
+// convertToQuoteResponse конвертирует расчет в формат API.
+func convertToQuoteResponse(quote model.Quote) *order_v1.QuoteOrderResponse {
+	items := make([]order_v1.QuoteItem, 0, len(quote.Items))
+	for _, item := range quote.Items {
+		items = append(items, order_v1.QuoteItem{
+			PartUUID: item.PartUUID,
+			Name:     item.Name,
+			Category: item.Category,
+			Price:    item.Price,
+		})
+	}
+
+	unavailable := quote.UnavailablePartUUIDs
+	if unavailable == nil {
+		unavailable = []uuid.UUID{}
+	}
+
+	return &order_v1.QuoteOrderResponse{
+		QuoteUUID:            quote.QuoteUUID,
+		ExpiresAt:            quote.ExpiresAt,
+		Items:                items,
+		UnavailablePartUuids: unavailable,
+		SubtotalPrice:        quote.SubtotalPrice,
+		Discount:             convertToAppliedDiscount(quote.Discount),
+		TotalPrice:           quote.TotalPrice,
+	}
+}
@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/andredubov/rocket-factory/order/internal/discount"
+	"github.com/andredubov/rocket-factory/order/internal/pricing"
+	"github.com/andredubov/rocket-factory/order/internal/repository/model"
+	order_v1 "github.com/andredubov/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+// revalidatePrices сверяет цены деталей заказа с текущими ценами склада перед оплатой
+// и применяет политику сервиса к изменившимся ценам. При пересчете обновляет стоимость заказа.
+// Возвращает цены, по которым будет списана сумма заказа, либо ответ с ошибкой,
+// если заказ нельзя оплатить.
+// Политике honor текущие цены не нужны, поэтому при ошибке InventoryService
+// заказ оплачивается по ценам заказа, а политика off не обращается к InventoryService вовсе.
+func (i *OrderImplementation) revalidatePrices(ctx context.Context, order *model.Order) (model.PriceBasis, order_v1.PayOrderRes, error) {
+	if i.pricePolicy == pricing.PolicyOff {
+		return model.PriceBasisOrder, nil, nil
+	}
+
+	// Получение текущих цен всех деталей одним запросом
+	parts, err := i.getParts(ctx, uniquePartUUIDs(order.PartUUIDs))
+	if err != nil {
+		if i.pricePolicy == pricing.PolicyHonor {
+			slog.WarnContext(ctx, "order charged by order prices without re-validation",
+				"order_uuid", order.OrderUUID, "error", err)
+
+			return model.PriceBasisOrder, nil, nil
+		}
+		if isServiceUnavailable(err) {
+			return "", newServiceUnavailableError("inventory"), nil
+		}
+		return "", newBadGatewayError("inventory", err), nil
+	}
+
+	currentPrices := make(map[uuid.UUID]float64, len(parts))
+	for partUUID, part := range parts {
+		currentPrices[partUUID] = part.GetPrice()
+	}
+
+	changes := pricing.Compare(order.PartUUIDs, order.PartPrices, currentPrices)
+	if len(changes) == 0 {
+		return model.PriceBasisOrder, nil, nil
+	}
+
+	// Удаленные из каталога детали нельзя оплатить ни при какой политике
+	if pricing.HasRemoved(changes) {
+		return "", newPriceConflictError("some parts of the order were removed from the catalog", changes), nil
+	}
+
+	switch i.pricePolicy {
+	case pricing.PolicyReject:
+		return "", newPriceConflictError(
+			fmt.Sprintf("prices of %d parts changed since the order was created", len(changes)), changes), nil
+	case pricing.PolicyReprice:
+		// Пересчет стоимости и скидки по текущим ценам
+		items := make([]discount.Item, 0, len(order.PartUUIDs))
+		for _, partUUID := range order.PartUUIDs {
+			items = append(items, discount.Item{
+				PartUUID: partUUID,
+				Category: partCategory(parts[partUUID].GetCategory()),
+				Price:    decimal.NewFromFloat(currentPrices[partUUID]),
+			})
+		}
+
+		subtotal := discount.Subtotal(items)
+		total := subtotal
+
+		orderDiscount := order.Discount
+		if orderDiscount != nil {
+			var badRequest *order_v1.BadRequestError
+			orderDiscount, badRequest, err = i.recalculateDiscount(ctx, orderDiscount, items)
+			if err != nil {
+				return "", nil, err
+			}
+			if badRequest != nil {
+				return "", newPriceConflictError(badRequest.Message, changes), nil
+			}
+			total = total.Sub(decimal.NewFromFloat(orderDiscount.Amount))
+		}
+
+		total = total.Round(2)
+		orderTotal := decimal.NewFromFloat(order.TotalPrice)
+		if !pricing.WithinTolerance(orderTotal, total, decimal.NewFromFloat(i.priceDriftTolerance)) {
+			return "", newPriceConflictError(fmt.Sprintf("order total changed from %s to %s, more than %v%% allowed",
+				orderTotal.StringFixed(2), total.StringFixed(2), i.priceDriftTolerance), changes), nil
+		}
+
+		order.PartPrices = partPrices(items)
+		order.Discount = orderDiscount
+		order.SubtotalPrice, _ = subtotal.Round(2).Float64()
+		order.TotalPrice, _ = total.Float64()
+
+		slog.InfoContext(ctx, "order repriced at payment",
+			"order_uuid", order.OrderUUID, "total_price", order.TotalPrice, "total_price_was", orderTotal.InexactFloat64())
+
+		return model.PriceBasisCurrent, nil, nil
+	default:
+		slog.InfoContext(ctx, "order charged by order prices despite price changes",
+			"order_uuid", order.OrderUUID, "changed_parts", len(changes))
+
+		return model.PriceBasisOrder, nil, nil
+	}
+}
+
+// newPriceConflictError формирует ответ 409 со списком изменений цен деталей заказа.
+func newPriceConflictError(reason string, changes []pricing.Change) *order_v1.ConflictError {
+	priceChanges := make([]order_v1.PriceChange, 0, len(changes))
+	for _, change := range changes {
+		priceChange := order_v1.PriceChange{
+			PartUUID:   change.PartUUID,
+			OrderPrice: change.OrderPrice,
+			Removed:    change.Removed,
+		}
+		if !change.Removed {
+			priceChange.CurrentPrice = order_v1.NewOptFloat64(change.CurrentPrice)
+		}
+		priceChanges = append(priceChanges, priceChange)
+	}
+
+	return &order_v1.ConflictError{
+		Code:         http.StatusConflict,
+		Message:      fmt.Sprintf("order can't be paid: %s", reason),
+		PriceChanges: priceChanges,
+	}
+}
@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/andredubov/rocket-factory/order/internal/discount"
+	"github.com/andredubov/rocket-factory/order/internal/repository"
+	"github.com/andredubov/rocket-factory/order/internal/repository/model"
+	order_v1 "github.com/andredubov/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+// UpdateOrder обрабатывает запрос на изменение списка деталей заказа.
+// Стоимость пересчитывается по текущим ценам всех деталей заказа.
+func (i *OrderImplementation) UpdateOrder(ctx context.Context, req *order_v1.UpdateOrderRequest, params order_v1.UpdateOrderParams) (order_v1.UpdateOrderRes, error) {
+	// Валидация
+	if len(req.GetAddPartUuids()) == 0 && len(req.GetRemovePartUuids()) == 0 {
+		return &order_v1.BadRequestError{
+			Code:    http.StatusBadRequest,
+			Message: "at least one part to add or remove required",
+		}, nil
+	}
+
+	// Получаем заказ из репозитория
+	order, err := i.ordersRepository.GetOrder(ctx, params.OrderUUID)
+	if err != nil {
+		if errors.Is(err, repository.ErrOrderNotFound) {
+			return &order_v1.NotFoundError{
+				Code:    http.StatusNotFound,
+				Message: "order not found",
+			}, nil
+		}
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	// Изменять можно только заказы, ожидающие оплаты
+	switch order.Status {
+	case model.OrderStatusPaid:
+		return &order_v1.ConflictError{
+			Code:    http.StatusConflict,
+			Message: "order has been paid and cannot be changed",
+		}, nil
+	case model.OrderStatusCancelled:
+		return &order_v1.ConflictError{
+			Code:    http.StatusConflict,
+			Message: "order is cancelled and cannot be changed",
+		}, nil
+	}
+	if order.PaymentStartedAt != nil {
+		return newPaymentInProgressError(), nil
+	}
+
+	// Формирование нового списка деталей
+	partUUIDs := append(slices.Clone(order.PartUUIDs), req.GetAddPartUuids()...)
+	for _, partUUID := range req.GetRemovePartUuids() {
+		index := slices.Index(partUUIDs, partUUID)
+		if index < 0 {
+			return &order_v1.BadRequestError{
+				Code:    http.StatusBadRequest,
+				Message: fmt.Sprintf("part %s is not in the order", partUUID),
+			}, nil
+		}
+		partUUIDs = slices.Delete(partUUIDs, index, index+1)
+	}
+
+	if len(partUUIDs) == 0 {
+		return &order_v1.BadRequestError{
+			Code:    http.StatusBadRequest,
+			Message: "order must contain at least one part, cancel it instead",
+		}, nil
+	}
+
+	// Проверка наличия деталей и пересчет стоимости
+	items, res := i.priceParts(ctx, partUUIDs)
+	if res != nil {
+		return res, nil
+	}
+
+	subtotal := discount.Subtotal(items)
+	total := subtotal
+
+	// Пересчет скидки по промокоду заказа
+	orderDiscount := order.Discount
+	if orderDiscount != nil {
+		var badRequest *order_v1.BadRequestError
+		orderDiscount, badRequest, err = i.recalculateDiscount(ctx, orderDiscount, items)
+		if err != nil {
+			return nil, err
+		}
+		if badRequest != nil {
+			return badRequest, nil
+		}
+		total = total.Sub(decimal.NewFromFloat(orderDiscount.Amount))
+	}
+
+	order.PartUUIDs = partUUIDs
+	order.PartPrices = partPrices(items)
+	order.Discount = orderDiscount
+	order.SubtotalPrice, _ = subtotal.Round(2).Float64()
+	order.TotalPrice, _ = total.Round(2).Float64()
+	order.UpdatedAt = i.clock.Now()
+
+	// Обновляем заказ, только если его не оплатили, не отменили и не изменили после чтения
+	if err := i.ordersRepository.UpdateOrderIf(ctx, *order, order.Version); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrOrderNotFound):
+			return &order_v1.NotFoundError{
+				Code:    http.StatusNotFound,
+				Message: "order not found",
+			}, nil
+		case errors.Is(err, repository.ErrOrderModified):
+			return &order_v1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: "order was changed by another request, retry the update",
+			}, nil
+		}
+		return nil, fmt.Errorf("failed to update order: %w", err)
+	}
+
+	// Учет бизнес-метрик
+	i.metrics.OrderUpdated(*order)
+
+	updated, err := convertToOrderResponse(*order)
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
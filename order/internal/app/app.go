@@ -0,0 +1,212 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/andredubov/golibs/pkg/closer"
+	"github.com/andredubov/golibs/pkg/config"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+
+	"github.com/andredubov/rocket-factory/shared/pkg/logger"
+	"github.com/andredubov/rocket-factory/shared/pkg/metrics"
+	order_v1 "github.com/andredubov/rocket-factory/shared/pkg/openapi/order/v1"
+	"github.com/andredubov/rocket-factory/shared/pkg/tracing"
+)
+
+const (
+	// drainDelay gives load balancers time to notice failing readiness before listener is closed
+	drainDelay = 5 * time.Second
+	// shutdownTimeout limits how long in-flight HTTP requests may take on shutdown
+	shutdownTimeout = 30 * time.Second
+	// tracingShutdownTimeout limits how long buffered spans may be flushed on shutdown
+	tracingShutdownTimeout = 5 * time.Second
+)
+
+// App represents the core application structure
+// Manages lifecycle and dependencies of the service
+type App struct {
+	serviceProvider *serviceProvider
+	httpServer      *http.Server
+}
+
+// NewApp constructs new application instance
+// Initializes all dependencies through initDeps
+func NewApp(ctx context.Context) (*App, error) {
+	application := &App{}
+	if err := application.initDeps(ctx); err != nil {
+		return nil, err
+	}
+
+	return application, nil
+}
+
+// Run starts the application:
+// 1. Launches HTTP server
+// 2. Sets up graceful shutdown via closer
+func (a *App) Run() error {
+	defer func() {
+		closer.CloseAll()
+		closer.Wait()
+	}()
+
+	return a.runHTTPServer()
+}
+
+// initDeps initializes application dependencies
+// Executes initialization functions sequentially
+func (a *App) initDeps(ctx context.Context) error {
+	inits := []func(context.Context) error{
+		a.initConfig,          // Load configuration
+		a.initServiceProvider, // Initialize service container
+		a.initLogger,          // Setup structured logger
+		a.initTracing,         // Setup tracer provider
+		a.initHTTPServer,      // Setup HTTP server
+	}
+
+	for _, f := range inits {
+		if err := f(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// initConfig loads application configuration
+func (a *App) initConfig(_ context.Context) error {
+	err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// initServiceProvider creates service provider instance
+func (a *App) initServiceProvider(_ context.Context) error {
+	a.serviceProvider = newServiceProvider()
+	return nil
+}
+
+// initLogger installs structured logger as default one
+func (a *App) initLogger(_ context.Context) error {
+	logger.Init(a.serviceProvider.LoggerConfig())
+	return nil
+}
+
+// initTracing installs global tracer provider
+// Buffered spans are flushed on application shutdown
+func (a *App) initTracing(ctx context.Context) error {
+	shutdown, err := tracing.Init(ctx, a.serviceProvider.TracingConfig())
+	if err != nil {
+		return err
+	}
+
+	closer.Add(func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+		defer cancel()
+
+		return shutdown(ctx)
+	})
+
+	return nil
+}
+
+// initHTTPServer configures HTTP server:
+// 1. Creates ogen server with order API handler
+// 2. Registers liveness and readiness probes, /metrics endpoint and orders export
+// 3. Mounts API on chi router with tracing, metrics, request ID logging and panic recovery
+func (a *App) initHTTPServer(ctx context.Context) error {
+	orderServer, err := order_v1.NewServer(a.serviceProvider.OrderHandler(ctx))
+	if err != nil {
+		return err
+	}
+
+	healthHandler := a.serviceProvider.HealthHandler(ctx)
+
+	router := chi.NewRouter()
+	router.Use(tracing.HTTPMiddleware("order"))
+	router.Use(a.serviceProvider.HTTPMetrics().Middleware(routeName(orderServer)))
+	router.Use(logger.HTTPMiddleware)
+	router.Use(middleware.Recoverer)
+	router.Get("/healthz", healthHandler.Liveness)
+	router.Get("/readyz", healthHandler.Readiness)
+	router.Method(http.MethodGet, "/metrics", metrics.Handler())
+	if a.serviceProvider.ExportConfig().Token() != "" {
+		router.Method(http.MethodGet, "/orders/export", a.serviceProvider.ExportHandler(ctx))
+	} else {
+		slog.Warn("orders export is disabled, EXPORT_API_TOKEN is not set")
+	}
+	router.Mount("/", orderServer)
+
+	a.httpServer = &http.Server{
+		Addr:              a.serviceProvider.HTTPConfig().Address(),
+		Handler:           router,
+		ReadHeaderTimeout: a.serviceProvider.HTTPConfig().ReadHeaderTimeout(),
+	}
+
+	return nil
+}
+
+// routeName labels requests with API operation or chi route pattern
+func routeName(orderServer *order_v1.Server) metrics.RouteFunc {
+	return func(r *http.Request) (string, bool) {
+		if route, ok := orderServer.FindRoute(r.Method, r.URL.Path); ok {
+			return route.OperationID(), true
+		}
+
+		if rctx := chi.RouteContext(r.Context()); rctx != nil {
+			if pattern := rctx.RoutePattern(); pattern != "" && pattern != "/*" {
+				return pattern, true
+			}
+		}
+
+		return "", false
+	}
+}
+
+// runHTTPServer starts HTTP server on configured address
+// and blocks until a termination signal is received
+func (a *App) runHTTPServer() error {
+	slog.Info("HTTP server starting", "address", a.httpServer.Addr)
+
+	errCh := make(chan error, 1)
+	go func() {
+		err := a.httpServer.ListenAndServe()
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errCh <- err
+		}
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM, syscall.SIGQUIT)
+	defer signal.Stop(quit)
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-quit:
+	}
+
+	// Fail readiness first so that no new traffic arrives while draining
+	a.serviceProvider.HealthHandler(context.Background()).SetDraining()
+	time.Sleep(drainDelay)
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := a.httpServer.Shutdown(ctx); err != nil {
+		return err
+	}
+
+	slog.Info("HTTP server stopped")
+	return nil
+}
@@ -0,0 +1,401 @@
+package app
+
+import (
+	"context"
+
+	"github.com/andredubov/golibs/pkg/closer"
+	"github.com/andredubov/golibs/pkg/config"
+	"github.com/andredubov/golibs/pkg/config/env"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+
+	"github.com/andredubov/rocket-factory/order/internal/api/export"
+	"github.com/andredubov/rocket-factory/order/internal/api/health"
+	handler "github.com/andredubov/rocket-factory/order/internal/api/v1/order"
+	client "github.com/andredubov/rocket-factory/order/internal/client/grpc"
+	"github.com/andredubov/rocket-factory/order/internal/clock"
+	orderconfig "github.com/andredubov/rocket-factory/order/internal/config"
+	orderenv "github.com/andredubov/rocket-factory/order/internal/config/env"
+	ordermetrics "github.com/andredubov/rocket-factory/order/internal/metrics"
+	"github.com/andredubov/rocket-factory/order/internal/repository"
+	"github.com/andredubov/rocket-factory/order/internal/repository/model"
+	"github.com/andredubov/rocket-factory/order/internal/repository/order/memory"
+	"github.com/andredubov/rocket-factory/order/internal/repository/order/traced"
+	promomemory "github.com/andredubov/rocket-factory/order/internal/repository/promo/memory"
+	promotraced "github.com/andredubov/rocket-factory/order/internal/repository/promo/traced"
+	quotememory "github.com/andredubov/rocket-factory/order/internal/repository/quote/memory"
+	quotetraced "github.com/andredubov/rocket-factory/order/internal/repository/quote/traced"
+	"github.com/andredubov/rocket-factory/shared/pkg/logger"
+	"github.com/andredubov/rocket-factory/shared/pkg/metrics"
+	inventory_v1 "github.com/andredubov/rocket-factory/shared/pkg/proto/inventory/v1"
+	payment_v1 "github.com/andredubov/rocket-factory/shared/pkg/proto/payment/v1"
+	"github.com/andredubov/rocket-factory/shared/pkg/tlsconfig"
+	"github.com/andredubov/rocket-factory/shared/pkg/tracing"
+)
+
+// serviceName identifies the service in logs and traces
+const serviceName = "order"
+
+// serviceProvider implements the dependency container pattern
+// It provides lazy initialization of application components
+type serviceProvider struct {
+	httpConfig            config.HTTPConfig                   // HTTP server configuration
+	loggerConfig          logger.Config                       // Log level and format
+	tracingConfig         tracing.Config                      // Span sampling and export settings
+	inventoryClientConfig orderconfig.GRPCClientConfig        // Inventory service connection settings
+	paymentClientConfig   orderconfig.GRPCClientConfig        // Payment service connection settings
+	promoConfig           orderconfig.PromoConfig             // Promo codes source
+	quoteConfig           orderconfig.QuoteConfig             // Lifetime of price quotes
+	exportConfig          orderconfig.ExportConfig            // Access to the orders export
+	pricingConfig         orderconfig.PricingConfig           // Pay-time price re-validation
+	inventoryConn         *grpc.ClientConn                    // Connection to the inventory service
+	paymentConn           *grpc.ClientConn                    // Connection to the payment service
+	inventoryClient       inventory_v1.InventoryServiceClient // Inventory service gRPC client
+	paymentClient         payment_v1.PaymentServiceClient     // Payment service gRPC client
+	ordersRepository      repository.Orders                   // Orders data access layer
+	promoCodesRepository  repository.PromoCodes               // Promo codes and their redemptions
+	quotesRepository      repository.Quotes                   // Order price quotes
+	orderHandler          *handler.OrderImplementation        // HTTP API handler implementation
+	healthHandler         *health.Handler                     // Liveness and readiness probes
+	exportHandler         *export.Handler                     // Orders export for finance
+	httpMetrics           *metrics.HTTPMetrics                // HTTP RED metrics
+	grpcClientMetrics     *metrics.GRPCClientMetrics          // Downstream RPC RED metrics
+	orderMetrics          *ordermetrics.Orders                // Order business metrics
+	clock                 clock.Clock                         // Source of current time
+}
+
+// newServiceProvider creates a new service provider instance
+func newServiceProvider() *serviceProvider {
+	return &serviceProvider{}
+}
+
+// HTTPConfig loads HTTP server configuration from environment variables
+// Implements singleton pattern - initializes config only once
+func (s *serviceProvider) HTTPConfig() config.HTTPConfig {
+	if s.httpConfig == nil {
+		cfg, err := env.NewHTTPConfig()
+		if err != nil {
+			logger.Fatal("failed to get http config", "error", err)
+		}
+		s.httpConfig = cfg
+	}
+
+	return s.httpConfig
+}
+
+// LoggerConfig loads logging configuration from environment variables
+func (s *serviceProvider) LoggerConfig() logger.Config {
+	if s.loggerConfig == nil {
+		cfg, err := logger.NewEnvConfig(serviceName)
+		if err != nil {
+			logger.Fatal("failed to get logger config", "error", err)
+		}
+		s.loggerConfig = cfg
+	}
+
+	return s.loggerConfig
+}
+
+// TracingConfig loads tracing configuration from environment variables
+func (s *serviceProvider) TracingConfig() tracing.Config {
+	if s.tracingConfig == nil {
+		cfg, err := tracing.NewEnvConfig(serviceName)
+		if err != nil {
+			logger.Fatal("failed to get tracing config", "error", err)
+		}
+		s.tracingConfig = cfg
+	}
+
+	return s.tracingConfig
+}
+
+// InventoryClientConfig loads inventory service connection settings from environment variables
+func (s *serviceProvider) InventoryClientConfig() orderconfig.GRPCClientConfig {
+	if s.inventoryClientConfig == nil {
+		cfg, err := orderenv.NewInventoryClientConfig()
+		if err != nil {
+			logger.Fatal("failed to get inventory client config", "error", err)
+		}
+		s.inventoryClientConfig = cfg
+	}
+
+	return s.inventoryClientConfig
+}
+
+// PaymentClientConfig loads payment service connection settings from environment variables
+func (s *serviceProvider) PaymentClientConfig() orderconfig.GRPCClientConfig {
+	if s.paymentClientConfig == nil {
+		cfg, err := orderenv.NewPaymentClientConfig()
+		if err != nil {
+			logger.Fatal("failed to get payment client config", "error", err)
+		}
+		s.paymentClientConfig = cfg
+	}
+
+	return s.paymentClientConfig
+}
+
+// PromoConfig loads promo codes settings from environment variables
+func (s *serviceProvider) PromoConfig() orderconfig.PromoConfig {
+	if s.promoConfig == nil {
+		cfg, err := orderenv.NewPromoConfig()
+		if err != nil {
+			logger.Fatal("failed to get promo config", "error", err)
+		}
+		s.promoConfig = cfg
+	}
+
+	return s.promoConfig
+}
+
+// QuoteConfig loads order quotes settings from environment variables
+func (s *serviceProvider) QuoteConfig() orderconfig.QuoteConfig {
+	if s.quoteConfig == nil {
+		cfg, err := orderenv.NewQuoteConfig()
+		if err != nil {
+			logger.Fatal("failed to get quote config", "error", err)
+		}
+		s.quoteConfig = cfg
+	}
+
+	return s.quoteConfig
+}
+
+// ExportConfig loads orders export settings from environment variables
+func (s *serviceProvider) ExportConfig() orderconfig.ExportConfig {
+	if s.exportConfig == nil {
+		cfg, err := orderenv.NewExportConfig()
+		if err != nil {
+			logger.Fatal("failed to get export config", "error", err)
+		}
+		s.exportConfig = cfg
+	}
+
+	return s.exportConfig
+}
+
+// PricingConfig loads pay-time price re-validation settings from environment variables
+func (s *serviceProvider) PricingConfig() orderconfig.PricingConfig {
+	if s.pricingConfig == nil {
+		cfg, err := orderenv.NewPricingConfig()
+		if err != nil {
+			logger.Fatal("failed to get pricing config", "error", err)
+		}
+		s.pricingConfig = cfg
+	}
+
+	return s.pricingConfig
+}
+
+// InventoryConn creates resilient connection to the inventory service
+// Read-only inventory RPCs are idempotent and therefore retried
+// The connection is closed on application shutdown
+func (s *serviceProvider) InventoryConn(_ context.Context) *grpc.ClientConn {
+	if s.inventoryConn == nil {
+		cfg := s.InventoryClientConfig()
+		serviceConfig := client.RetryServiceConfig(
+			inventory_v1.InventoryService_ServiceDesc.ServiceName,
+			cfg.MaxAttempts(),
+			"GetPart",
+			"ListParts",
+			"ValidateConfiguration",
+		)
+		s.inventoryConn = s.newClientConn("inventory", cfg, serviceConfig)
+	}
+
+	return s.inventoryConn
+}
+
+// PaymentConn creates resilient connection to the payment service
+// PayOrder is not idempotent, so payment calls are never retried
+// The connection is closed on application shutdown
+func (s *serviceProvider) PaymentConn(_ context.Context) *grpc.ClientConn {
+	if s.paymentConn == nil {
+		s.paymentConn = s.newClientConn("payment", s.PaymentClientConfig(), "")
+	}
+
+	return s.paymentConn
+}
+
+// InventoryClient creates gRPC client of the inventory service
+func (s *serviceProvider) InventoryClient(ctx context.Context) inventory_v1.InventoryServiceClient {
+	if s.inventoryClient == nil {
+		s.inventoryClient = inventory_v1.NewInventoryServiceClient(s.InventoryConn(ctx))
+	}
+
+	return s.inventoryClient
+}
+
+// PaymentClient creates gRPC client of the payment service
+func (s *serviceProvider) PaymentClient(ctx context.Context) payment_v1.PaymentServiceClient {
+	if s.paymentClient == nil {
+		s.paymentClient = payment_v1.NewPaymentServiceClient(s.PaymentConn(ctx))
+	}
+
+	return s.paymentClient
+}
+
+// OrdersRepository provides access to orders data
+// Uses in-memory implementation wrapped with tracing and singleton pattern
+func (s *serviceProvider) OrdersRepository(_ context.Context) repository.Orders {
+	if s.ordersRepository == nil {
+		s.ordersRepository = traced.NewOrderRepository(memory.NewOrderRepository())
+	}
+
+	return s.ordersRepository
+}
+
+// PromoCodesRepository provides access to promo codes
+// Codes are seeded from the configured file, no codes are available without it
+func (s *serviceProvider) PromoCodesRepository(_ context.Context) repository.PromoCodes {
+	if s.promoCodesRepository == nil {
+		var codes []model.PromoCode
+		if file := s.PromoConfig().CodesFile(); file != "" {
+			var err error
+			codes, err = promomemory.LoadPromoCodes(file)
+			if err != nil {
+				logger.Fatal("failed to load promo codes", "error", err)
+			}
+		}
+
+		repo, err := promomemory.NewPromoCodeRepository(codes)
+		if err != nil {
+			logger.Fatal("failed to create promo codes repository", "error", err)
+		}
+		s.promoCodesRepository = promotraced.NewPromoCodeRepository(repo)
+	}
+
+	return s.promoCodesRepository
+}
+
+// QuotesRepository provides access to order price quotes
+// Uses in-memory implementation wrapped with tracing
+func (s *serviceProvider) QuotesRepository(_ context.Context) repository.Quotes {
+	if s.quotesRepository == nil {
+		s.quotesRepository = quotetraced.NewQuoteRepository(quotememory.NewQuoteRepository(s.Clock()))
+	}
+
+	return s.quotesRepository
+}
+
+// OrderHandler creates HTTP API handler
+// Initializes all required dependencies (repositories and gRPC clients)
+func (s *serviceProvider) OrderHandler(ctx context.Context) *handler.OrderImplementation {
+	if s.orderHandler == nil {
+		s.orderHandler = handler.NewOrderHandler(
+			s.OrdersRepository(ctx),
+			s.PromoCodesRepository(ctx),
+			s.QuotesRepository(ctx),
+			s.QuoteConfig().TTL(),
+			s.PricingConfig().Policy(),
+			s.PricingConfig().DriftTolerancePercent(),
+			s.PaymentClient(ctx),
+			s.InventoryClient(ctx),
+			s.OrderMetrics(),
+			s.Clock(),
+		)
+	}
+
+	return s.orderHandler
+}
+
+// ExportHandler creates orders export handler
+func (s *serviceProvider) ExportHandler(ctx context.Context) *export.Handler {
+	if s.exportHandler == nil {
+		s.exportHandler = export.NewHandler(s.OrdersRepository(ctx), s.ExportConfig().Token())
+	}
+
+	return s.exportHandler
+}
+
+// HTTPMetrics creates RED metrics of the HTTP API
+// Metrics are exported from the default prometheus registry
+func (s *serviceProvider) HTTPMetrics() *metrics.HTTPMetrics {
+	if s.httpMetrics == nil {
+		s.httpMetrics = metrics.NewHTTPMetrics(prometheus.DefaultRegisterer)
+	}
+
+	return s.httpMetrics
+}
+
+// GRPCClientMetrics creates RED metrics of calls to downstream services
+func (s *serviceProvider) GRPCClientMetrics() *metrics.GRPCClientMetrics {
+	if s.grpcClientMetrics == nil {
+		s.grpcClientMetrics = metrics.NewGRPCClientMetrics(prometheus.DefaultRegisterer)
+	}
+
+	return s.grpcClientMetrics
+}
+
+// OrderMetrics creates business metrics of the order lifecycle
+func (s *serviceProvider) OrderMetrics() *ordermetrics.Orders {
+	if s.orderMetrics == nil {
+		s.orderMetrics = ordermetrics.NewOrders(prometheus.DefaultRegisterer)
+	}
+
+	return s.orderMetrics
+}
+
+// Clock provides current time to handlers
+func (s *serviceProvider) Clock() clock.Clock {
+	if s.clock == nil {
+		s.clock = clock.New()
+	}
+
+	return s.clock
+}
+
+// HealthHandler creates liveness and readiness probes
+// Readiness reflects orders storage and both downstream gRPC services
+func (s *serviceProvider) HealthHandler(ctx context.Context) *health.Handler {
+	if s.healthHandler == nil {
+		s.healthHandler = health.NewHandler()
+		s.healthHandler.AddCheck("orders_repository", s.OrdersRepository(ctx).Ping)
+		s.healthHandler.AddCheck("inventory", health.GRPCCheck(
+			s.InventoryConn(ctx),
+			inventory_v1.InventoryService_ServiceDesc.ServiceName,
+		))
+		s.healthHandler.AddCheck("payment", health.GRPCCheck(
+			s.PaymentConn(ctx),
+			payment_v1.PaymentService_ServiceDesc.ServiceName,
+		))
+	}
+
+	return s.healthHandler
+}
+
+// newClientConn creates resilient and instrumented gRPC connection
+// to a downstream service and registers it in closer
+// Request ID of the incoming HTTP request is passed to the service
+// Client certificates are reloaded from disk until shutdown
+func (s *serviceProvider) newClientConn(name string, cfg orderconfig.GRPCClientConfig, serviceConfig string) *grpc.ClientConn {
+	creds, reloader, err := tlsconfig.NewClientCredentials(cfg.TLS(), cfg.Address())
+	if err != nil {
+		logger.Fatal("failed to load tls credentials", "service", name, "error", err)
+	}
+	if reloader != nil {
+		watchCertificates(reloader)
+	}
+
+	conn, err := client.NewConn(name, cfg, creds, serviceConfig,
+		logger.UnaryClientInterceptor(),
+		s.GRPCClientMetrics().UnaryClientInterceptor(),
+	)
+	if err != nil {
+		logger.Fatal("failed to create grpc client", "service", name, "error", err)
+	}
+	closer.Add(conn.Close)
+
+	return conn
+}
+
+// watchCertificates reloads certificates of a connection until application shutdown
+func watchCertificates(reloader *tlsconfig.Reloader) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go reloader.Watch(ctx)
+
+	closer.Add(func() error {
+		cancel()
+		return nil
+	})
+}
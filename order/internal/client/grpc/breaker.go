@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/sony/gobreaker/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CircuitBreaker stops calling a downstream service after a series of consecutive failures
+// and lets a single probe request through once the open timeout has passed.
+type CircuitBreaker struct {
+	name    string
+	breaker *gobreaker.CircuitBreaker[struct{}]
+}
+
+// NewCircuitBreaker creates a circuit breaker that opens after failureThreshold
+// consecutive failures and stays open for openTimeout.
+func NewCircuitBreaker(name string, failureThreshold uint32, openTimeout time.Duration) *CircuitBreaker {
+	settings := gobreaker.Settings{
+		Name:    name,
+		Timeout: openTimeout,
+		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			return counts.ConsecutiveFailures >= failureThreshold
+		},
+		IsSuccessful: func(err error) bool {
+			return !isDependencyFailure(err)
+		},
+		OnStateChange: func(name string, from, to gobreaker.State) {
+			slog.Warn("circuit breaker changed state", "breaker", name, "from", from.String(), "to", to.String())
+		},
+	}
+
+	return &CircuitBreaker{
+		name:    name,
+		breaker: gobreaker.NewCircuitBreaker[struct{}](settings),
+	}
+}
+
+// UnaryClientInterceptor returns interceptor that routes unary RPCs through the circuit breaker.
+// Rejected calls fail with codes.Unavailable without reaching the network.
+func (c *CircuitBreaker) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		_, err := c.breaker.Execute(func() (struct{}, error) {
+			return struct{}{}, invoker(ctx, method, req, reply, cc, opts...)
+		})
+		if errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests) {
+			return status.Errorf(codes.Unavailable, "%s circuit breaker is open", c.name)
+		}
+
+		return err
+	}
+}
+
+// isDependencyFailure reports whether err means the dependency itself is unhealthy.
+// Errors caused by the request (not found, invalid argument, etc.) do not trip the breaker.
+func isDependencyFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Internal, codes.Unknown:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"encoding/json"
+)
+
+// Backoff between retry attempts of idempotent RPCs
+const (
+	retryInitialBackoff    = "0.1s"
+	retryMaxBackoff        = "1s"
+	retryBackoffMultiplier = 2.0
+)
+
+// retryableStatusCodes lists transient failures worth another attempt
+var retryableStatusCodes = []string{"UNAVAILABLE", "RESOURCE_EXHAUSTED"}
+
+type serviceConfig struct {
+	MethodConfig []methodConfig `json:"methodConfig"`
+}
+
+type methodConfig struct {
+	Name        []methodName `json:"name"`
+	RetryPolicy retryPolicy  `json:"retryPolicy"`
+}
+
+type methodName struct {
+	Service string `json:"service"`
+	Method  string `json:"method"`
+}
+
+type retryPolicy struct {
+	MaxAttempts          int      `json:"maxAttempts"`
+	InitialBackoff       string   `json:"initialBackoff"`
+	MaxBackoff           string   `json:"maxBackoff"`
+	BackoffMultiplier    float64  `json:"backoffMultiplier"`
+	RetryableStatusCodes []string `json:"retryableStatusCodes"`
+}
+
+// RetryServiceConfig builds gRPC service config that retries the given idempotent methods
+// of the service up to maxAttempts times with exponential backoff.
+// Returns empty string when retries are disabled (maxAttempts < 2).
+func RetryServiceConfig(service string, maxAttempts int, methods ...string) string {
+	if maxAttempts < 2 || len(methods) == 0 {
+		return ""
+	}
+
+	names := make([]methodName, len(methods))
+	for i, method := range methods {
+		names[i] = methodName{Service: service, Method: method}
+	}
+
+	cfg := serviceConfig{
+		MethodConfig: []methodConfig{{
+			Name: names,
+			RetryPolicy: retryPolicy{
+				MaxAttempts:          maxAttempts,
+				InitialBackoff:       retryInitialBackoff,
+				MaxBackoff:           retryMaxBackoff,
+				BackoffMultiplier:    retryBackoffMultiplier,
+				RetryableStatusCodes: retryableStatusCodes,
+			},
+		}},
+	}
+
+	raw, err := json.Marshal(cfg)
+	if err != nil {
+		return ""
+	}
+
+	return string(raw)
+}
@@ -0,0 +1,25 @@
+package clock
+
+import "time"
+
+// Clock provides current time to components that stamp or compare times
+// Injecting it instead of calling time.Now keeps time-dependent behavior deterministic in tests
+type Clock interface {
+	Now() time.Time
+}
+
+// Func adapts an ordinary function to the Clock interface
+type Func func() time.Time
+
+// Now returns result of the function
+func (f Func) Now() time.Time {
+	return f()
+}
+
+// New creates clock that returns current system time in UTC
+// Monotonic clock reading is stripped so that stored times compare and serialize predictably
+func New() Clock {
+	return Func(func() time.Time {
+		return time.Now().UTC().Round(0)
+	})
+}
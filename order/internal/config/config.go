@@ -0,0 +1,40 @@
+package config
+
+import (
+	"time"
+
+	"github.com/andredubov/rocket-factory/order/internal/pricing"
+	"github.com/andredubov/rocket-factory/shared/pkg/tlsconfig"
+)
+
+// GRPCClientConfig describes how to reach a downstream gRPC service
+// and how resilient calls to it should be
+type GRPCClientConfig interface {
+	Address() string
+	Timeout() time.Duration            // Deadline applied to every RPC
+	MaxAttempts() int                  // Attempts of idempotent RPCs including the first one
+	BreakerFailureThreshold() uint32   // Consecutive failures that open the circuit breaker
+	BreakerOpenTimeout() time.Duration // How long the open circuit breaker rejects calls
+	TLS() tlsconfig.ClientConfig       // Transport security of the connection
+}
+
+// PromoConfig describes where promo codes are loaded from
+type PromoConfig interface {
+	CodesFile() string // JSON seed file of promo codes, no codes are available if empty
+}
+
+// QuoteConfig describes lifetime of order price quotes
+type QuoteConfig interface {
+	TTL() time.Duration // How long quoted prices are honored
+}
+
+// ExportConfig describes access to the orders export
+type ExportConfig interface {
+	Token() string // Bearer token required by the export, the export is disabled if empty
+}
+
+// PricingConfig describes how order prices are re-validated at payment time
+type PricingConfig interface {
+	Policy() pricing.Policy         // What to do if inventory prices changed since the order was created
+	DriftTolerancePercent() float64 // Allowed drift of the order total for the reprice policy
+}
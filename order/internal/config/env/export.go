@@ -0,0 +1,25 @@
+package env
+
+import (
+	"os"
+
+	"github.com/andredubov/rocket-factory/order/internal/config"
+)
+
+const exportTokenEnvName = "EXPORT_API_TOKEN"
+
+type exportConfig struct {
+	token string
+}
+
+// NewExportConfig returns orders export settings, the export is disabled without a token
+func NewExportConfig() (config.ExportConfig, error) {
+	return &exportConfig{
+		token: os.Getenv(exportTokenEnvName),
+	}, nil
+}
+
+// Token returns bearer token required by the export
+func (cfg *exportConfig) Token() string {
+	return cfg.token
+}
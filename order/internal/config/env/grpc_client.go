@@ -0,0 +1,149 @@
+package env
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/andredubov/rocket-factory/order/internal/config"
+	"github.com/andredubov/rocket-factory/shared/pkg/tlsconfig"
+)
+
+const (
+	inventoryEnvPrefix = "INVENTORY"
+	paymentEnvPrefix   = "PAYMENT"
+
+	grpcHostEnvSuffix                    = "_GRPC_HOST"
+	grpcPortEnvSuffix                    = "_GRPC_PORT"
+	grpcTimeoutEnvSuffix                 = "_GRPC_TIMEOUT_MS"
+	grpcMaxAttemptsEnvSuffix             = "_GRPC_MAX_ATTEMPTS"
+	grpcBreakerFailureThresholdEnvSuffix = "_GRPC_BREAKER_FAILURE_THRESHOLD"
+	grpcBreakerOpenTimeoutEnvSuffix      = "_GRPC_BREAKER_OPEN_TIMEOUT_SEC"
+)
+
+// Defaults used when optional resilience settings are not set
+const (
+	defaultTimeout                 = 3 * time.Second
+	defaultMaxAttempts             = 3
+	defaultBreakerFailureThreshold = 5
+	defaultBreakerOpenTimeout      = 10 * time.Second
+)
+
+type grpcClientConfig struct {
+	host                    string
+	port                    string
+	timeout                 time.Duration
+	maxAttempts             int
+	breakerFailureThreshold uint32
+	breakerOpenTimeout      time.Duration
+	tls                     tlsconfig.ClientConfig
+}
+
+// NewInventoryClientConfig returns connection settings of the inventory service
+func NewInventoryClientConfig() (config.GRPCClientConfig, error) {
+	return newGRPCClientConfig(inventoryEnvPrefix)
+}
+
+// NewPaymentClientConfig returns connection settings of the payment service
+func NewPaymentClientConfig() (config.GRPCClientConfig, error) {
+	return newGRPCClientConfig(paymentEnvPrefix)
+}
+
+// newGRPCClientConfig reads settings of a downstream service from variables with the given prefix.
+// Host and port are required, resilience settings fall back to defaults, TLS is disabled by default.
+func newGRPCClientConfig(prefix string) (config.GRPCClientConfig, error) {
+	host := os.Getenv(prefix + grpcHostEnvSuffix)
+	if len(host) == 0 {
+		return nil, fmt.Errorf("%s grpc host not found", prefix)
+	}
+
+	port := os.Getenv(prefix + grpcPortEnvSuffix)
+	if len(port) == 0 {
+		return nil, fmt.Errorf("%s grpc port not found", prefix)
+	}
+
+	timeoutMs, err := intFromEnv(prefix+grpcTimeoutEnvSuffix, int(defaultTimeout.Milliseconds()))
+	if err != nil {
+		return nil, err
+	}
+
+	maxAttempts, err := intFromEnv(prefix+grpcMaxAttemptsEnvSuffix, defaultMaxAttempts)
+	if err != nil {
+		return nil, err
+	}
+
+	failureThreshold, err := intFromEnv(prefix+grpcBreakerFailureThresholdEnvSuffix, defaultBreakerFailureThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	openTimeoutSec, err := intFromEnv(prefix+grpcBreakerOpenTimeoutEnvSuffix, int(defaultBreakerOpenTimeout.Seconds()))
+	if err != nil {
+		return nil, err
+	}
+
+	tls, err := tlsconfig.NewClientEnvConfig(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	return &grpcClientConfig{
+		host:                    host,
+		port:                    port,
+		timeout:                 time.Duration(timeoutMs) * time.Millisecond,
+		maxAttempts:             maxAttempts,
+		breakerFailureThreshold: uint32(failureThreshold), // #nosec G115 -- validated to be positive
+		breakerOpenTimeout:      time.Duration(openTimeoutSec) * time.Second,
+		tls:                     tls,
+	}, nil
+}
+
+// intFromEnv parses a positive integer variable or returns fallback if it is not set
+func intFromEnv(name string, fallback int) (int, error) {
+	raw := os.Getenv(name)
+	if len(raw) == 0 {
+		return fallback, nil
+	}
+
+	value, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse %s: %w", name, err)
+	}
+	if value <= 0 {
+		return 0, fmt.Errorf("%s must be positive, got %d", name, value)
+	}
+
+	return value, nil
+}
+
+// Address returns downstream grpc service address
+func (cfg *grpcClientConfig) Address() string {
+	return net.JoinHostPort(cfg.host, cfg.port)
+}
+
+// Timeout returns deadline applied to every RPC
+func (cfg *grpcClientConfig) Timeout() time.Duration {
+	return cfg.timeout
+}
+
+// MaxAttempts returns number of attempts of idempotent RPCs
+func (cfg *grpcClientConfig) MaxAttempts() int {
+	return cfg.maxAttempts
+}
+
+// BreakerFailureThreshold returns number of consecutive failures that open the circuit breaker
+func (cfg *grpcClientConfig) BreakerFailureThreshold() uint32 {
+	return cfg.breakerFailureThreshold
+}
+
+// BreakerOpenTimeout returns how long the open circuit breaker rejects calls
+func (cfg *grpcClientConfig) BreakerOpenTimeout() time.Duration {
+	return cfg.breakerOpenTimeout
+}
+
+// TLS returns transport security settings of the connection
+func (cfg *grpcClientConfig) TLS() tlsconfig.ClientConfig {
+	return cfg.tls
+}
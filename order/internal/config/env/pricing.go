@@ -0,0 +1,62 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/andredubov/rocket-factory/order/internal/config"
+	"github.com/andredubov/rocket-factory/order/internal/pricing"
+)
+
+const (
+	pricePolicyEnvName         = "PRICE_REVALIDATION_POLICY"
+	priceDriftToleranceEnvName = "PRICE_DRIFT_TOLERANCE_PERCENT"
+)
+
+// defaultPricePolicy keeps charging prices the order was created with
+const defaultPricePolicy = pricing.PolicyHonor
+
+type pricingConfig struct {
+	policy                pricing.Policy
+	driftTolerancePercent float64
+}
+
+// NewPricingConfig returns pay-time price re-validation settings
+func NewPricingConfig() (config.PricingConfig, error) {
+	policy := defaultPricePolicy
+	if raw := os.Getenv(pricePolicyEnvName); len(raw) != 0 {
+		parsed, err := pricing.ParsePolicy(raw)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", pricePolicyEnvName, err)
+		}
+		policy = parsed
+	}
+
+	tolerance := 0.0
+	if raw := os.Getenv(priceDriftToleranceEnvName); len(raw) != 0 {
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", priceDriftToleranceEnvName, err)
+		}
+		if value < 0 {
+			return nil, fmt.Errorf("%s must not be negative, got %v", priceDriftToleranceEnvName, value)
+		}
+		tolerance = value
+	}
+
+	return &pricingConfig{
+		policy:                policy,
+		driftTolerancePercent: tolerance,
+	}, nil
+}
+
+// Policy returns what to do if prices changed since the order was created
+func (cfg *pricingConfig) Policy() pricing.Policy {
+	return cfg.policy
+}
+
+// DriftTolerancePercent returns allowed drift of the order total in percent
+func (cfg *pricingConfig) DriftTolerancePercent() float64 {
+	return cfg.driftTolerancePercent
+}
@@ -0,0 +1,25 @@
+package env
+
+import (
+	"os"
+
+	"github.com/andredubov/rocket-factory/order/internal/config"
+)
+
+const promoCodesFileEnvName = "PROMO_CODES_FILE"
+
+type promoConfig struct {
+	codesFile string
+}
+
+// NewPromoConfig returns promo codes settings, the seed file is optional
+func NewPromoConfig() (config.PromoConfig, error) {
+	return &promoConfig{
+		codesFile: os.Getenv(promoCodesFileEnvName),
+	}, nil
+}
+
+// CodesFile returns path to JSON seed file of promo codes
+func (cfg *promoConfig) CodesFile() string {
+	return cfg.codesFile
+}
@@ -0,0 +1,33 @@
+package env
+
+import (
+	"time"
+
+	"github.com/andredubov/rocket-factory/order/internal/config"
+)
+
+const quoteTTLEnvName = "QUOTE_TTL_MIN"
+
+// defaultQuoteTTL is used when quote lifetime is not set
+const defaultQuoteTTL = 15 * time.Minute
+
+type quoteConfig struct {
+	ttl time.Duration
+}
+
+// NewQuoteConfig returns order quotes settings
+func NewQuoteConfig() (config.QuoteConfig, error) {
+	ttlMin, err := intFromEnv(quoteTTLEnvName, int(defaultQuoteTTL.Minutes()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &quoteConfig{
+		ttl: time.Duration(ttlMin) * time.Minute,
+	}, nil
+}
+
+// TTL returns how long quoted prices are honored
+func (cfg *quoteConfig) TTL() time.Duration {
+	return cfg.ttl
+}
@@ -0,0 +1,86 @@
+package discount
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/andredubov/rocket-factory/order/internal/repository/model"
+)
+
+// Error definitions
+// All of them mean that the promo code can't be applied to the order
+var (
+	ErrNotYetValid     = errors.New("promo code is not valid yet")
+	ErrExpired         = errors.New("promo code has expired")
+	ErrMinOrderValue   = errors.New("order value is below promo code minimum")
+	ErrNoEligibleParts = errors.New("promo code doesn't apply to any part of the order")
+)
+
+// Item is a priced order line the discount may apply to
+type Item struct {
+	PartUUID uuid.UUID
+	Category string
+	Price    decimal.Decimal
+}
+
+// Subtotal returns sum of item prices
+func Subtotal(items []Item) decimal.Decimal {
+	subtotal := decimal.Zero
+	for _, item := range items {
+		subtotal = subtotal.Add(item.Price)
+	}
+
+	return subtotal
+}
+
+// Calculate validates promo code against the order at the given moment
+// and returns discount amount rounded to 2 decimal places.
+// Usage limits are not checked here, they are enforced on redemption.
+func Calculate(promo model.PromoCode, items []Item, now time.Time) (decimal.Decimal, error) {
+	if !promo.ValidFrom.IsZero() && now.Before(promo.ValidFrom) {
+		return decimal.Zero, ErrNotYetValid
+	}
+	if !promo.ExpiresAt.IsZero() && now.After(promo.ExpiresAt) {
+		return decimal.Zero, ErrExpired
+	}
+
+	return Amount(promo, items)
+}
+
+// Amount returns discount amount of promo code rounded to 2 decimal places
+// without checking its validity period, e.g. for an order the code was already applied to.
+//
+// Minimum order value is checked against subtotal of all items,
+// while the discount itself applies to items of the promo code categories only.
+// Fixed discount never exceeds the eligible amount.
+func Amount(promo model.PromoCode, items []Item) (decimal.Decimal, error) {
+	subtotal, eligible := Subtotal(items), decimal.Zero
+	for _, item := range items {
+		if len(promo.Categories) == 0 || slices.Contains(promo.Categories, item.Category) {
+			eligible = eligible.Add(item.Price)
+		}
+	}
+
+	minOrderValue := decimal.NewFromFloat(promo.MinOrderValue)
+	if subtotal.LessThan(minOrderValue) {
+		return decimal.Zero, fmt.Errorf("%w of %s", ErrMinOrderValue, minOrderValue.StringFixed(2))
+	}
+	if !eligible.IsPositive() {
+		return decimal.Zero, ErrNoEligibleParts
+	}
+
+	value := decimal.NewFromFloat(promo.Value)
+	switch promo.DiscountType {
+	case model.DiscountTypePercent:
+		return eligible.Mul(value).Div(decimal.NewFromInt(100)).Round(2), nil
+	case model.DiscountTypeFixed:
+		return decimal.Min(value, eligible).Round(2), nil
+	default:
+		return decimal.Zero, fmt.Errorf("unsupported discount type: %s", promo.DiscountType)
+	}
+}
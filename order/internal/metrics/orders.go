@@ -0,0 +1,79 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/andredubov/rocket-factory/order/internal/repository/model"
+)
+
+const (
+	namespace = "order"
+
+	eventCreated   = "created"
+	eventUpdated   = "updated"
+	eventPaid      = "paid"
+	eventCancelled = "cancelled"
+)
+
+// Orders records business metrics of the order lifecycle.
+// Orders that were not paid yet are labelled with UNKNOWN payment method.
+type Orders struct {
+	events *prometheus.CounterVec
+	value  *prometheus.HistogramVec
+}
+
+// NewOrders creates order business metrics and registers them in reg
+func NewOrders(reg prometheus.Registerer) *Orders {
+	m := &Orders{
+		events: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "orders_total",
+			Help:      "Number of orders created, updated, paid and cancelled.",
+		}, []string{"event", "payment_method"}),
+		value: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "order_value",
+			Help:      "Total price of created and paid orders.",
+			Buckets:   prometheus.ExponentialBuckets(100, 4, 10),
+		}, []string{"event", "payment_method"}),
+	}
+	reg.MustRegister(m.events, m.value)
+
+	return m
+}
+
+// OrderCreated records a new order
+func (m *Orders) OrderCreated(order model.Order) {
+	m.record(eventCreated, order)
+	m.value.WithLabelValues(eventCreated, paymentMethod(order)).Observe(order.TotalPrice)
+}
+
+// OrderUpdated records a change of parts of a pending order
+func (m *Orders) OrderUpdated(order model.Order) {
+	m.record(eventUpdated, order)
+}
+
+// OrderPaid records a successfully paid order
+func (m *Orders) OrderPaid(order model.Order) {
+	m.record(eventPaid, order)
+	m.value.WithLabelValues(eventPaid, paymentMethod(order)).Observe(order.TotalPrice)
+}
+
+// OrderCancelled records a cancelled order
+func (m *Orders) OrderCancelled(order model.Order) {
+	m.record(eventCancelled, order)
+}
+
+// record increments counter of the event
+func (m *Orders) record(event string, order model.Order) {
+	m.events.WithLabelValues(event, paymentMethod(order)).Inc()
+}
+
+// paymentMethod returns payment method label of the order
+func paymentMethod(order model.Order) string {
+	if order.PaymentInfo == nil {
+		return string(model.PaymentMethodUnknown)
+	}
+
+	return string(order.PaymentInfo.PaymentMethod)
+}
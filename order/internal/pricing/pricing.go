@@ -0,0 +1,95 @@
+package pricing
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Policy defines how the order is charged when inventory prices
+// changed between order creation and payment
+type Policy string
+
+// Valid Policy values
+const (
+	PolicyHonor   Policy = "honor"   // Charge prices the order was created with
+	PolicyReject  Policy = "reject"  // Reject payment if any price changed
+	PolicyReprice Policy = "reprice" // Charge current prices if the total drifted within tolerance
+	PolicyOff     Policy = "off"     // Charge prices the order was created with without asking inventory
+)
+
+// IsValid checks if the Policy has a valid value
+func (p Policy) IsValid() bool {
+	switch p {
+	case PolicyHonor, PolicyReject, PolicyReprice, PolicyOff:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParsePolicy converts raw value to Policy
+func ParsePolicy(raw string) (Policy, error) {
+	policy := Policy(raw)
+	if !policy.IsValid() {
+		return "", fmt.Errorf("unknown price revalidation policy %q", raw)
+	}
+
+	return policy, nil
+}
+
+// Change describes a part whose price differs from the order price
+// CurrentPrice is zero if the part was removed from the catalog
+type Change struct {
+	PartUUID     uuid.UUID
+	OrderPrice   float64
+	CurrentPrice float64
+	Removed      bool
+}
+
+// Compare returns changes of part prices in order of the first occurrence of every part.
+// Parts missing from current prices are reported as removed.
+func Compare(partUUIDs []uuid.UUID, orderPrices, currentPrices map[uuid.UUID]float64) []Change {
+	var changes []Change
+	seen := make(map[uuid.UUID]struct{}, len(partUUIDs))
+	for _, partUUID := range partUUIDs {
+		if _, ok := seen[partUUID]; ok {
+			continue
+		}
+		seen[partUUID] = struct{}{}
+
+		orderPrice := orderPrices[partUUID]
+		currentPrice, ok := currentPrices[partUUID]
+		switch {
+		case !ok:
+			changes = append(changes, Change{PartUUID: partUUID, OrderPrice: orderPrice, Removed: true})
+		case !decimal.NewFromFloat(currentPrice).Equal(decimal.NewFromFloat(orderPrice)):
+			changes = append(changes, Change{PartUUID: partUUID, OrderPrice: orderPrice, CurrentPrice: currentPrice})
+		}
+	}
+
+	return changes
+}
+
+// HasRemoved reports whether any of the parts was removed from the catalog
+func HasRemoved(changes []Change) bool {
+	for _, change := range changes {
+		if change.Removed {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WithinTolerance reports whether current total differs from the order total
+// by no more than tolerance percent of the order total in either direction
+func WithinTolerance(orderTotal, currentTotal, tolerancePercent decimal.Decimal) bool {
+	if orderTotal.IsZero() {
+		return currentTotal.IsZero()
+	}
+
+	drift := currentTotal.Sub(orderTotal).Abs().Div(orderTotal.Abs()).Mul(decimal.NewFromInt(100))
+	return drift.LessThanOrEqual(tolerancePercent)
+}
@@ -1,6 +1,10 @@
 package model
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type OrderStatus string
 
@@ -42,18 +46,46 @@ func (pm PaymentMethod) IsValid() bool {
 	}
 }
 
+type PriceBasis string
+
+// Valid PriceBasis values
+const (
+	PriceBasisOrder   PriceBasis = "ORDER_PRICES"   // Prices the order was created or last changed with
+	PriceBasisCurrent PriceBasis = "CURRENT_PRICES" // Inventory prices re-validated at payment time
+)
+
 // PaymentInfo contains details about order payment
 type PaymentInfo struct {
 	TransactionUUID uuid.UUID
 	PaymentMethod   PaymentMethod
+	PriceBasis      PriceBasis // Prices TotalPrice was charged by
+}
+
+// Discount contains details about promo code applied to the order
+type Discount struct {
+	PromoCode string
+	Amount    float64
 }
 
 // Order represents a customer order in the system
+// TotalPrice is SubtotalPrice reduced by the applied discount
+// Timestamps are managed by the server, PaidAt and CancelledAt are set on the corresponding transition only
+// PaymentStartedAt is set while the order is being paid, the order can't be changed or cancelled meanwhile
+// Version detects concurrent modifications of the order between reading and writing it
 type Order struct {
-	OrderUUID   uuid.UUID
-	UserUUID    uuid.UUID
-	PartUUIDs   []uuid.UUID
-	TotalPrice  float64
-	PaymentInfo *PaymentInfo
-	Status      OrderStatus
+	OrderUUID        uuid.UUID
+	UserUUID         uuid.UUID
+	PartUUIDs        []uuid.UUID
+	PartPrices       map[uuid.UUID]float64 // Unit prices of parts at order time
+	SubtotalPrice    float64
+	Discount         *Discount
+	TotalPrice       float64
+	PaymentInfo      *PaymentInfo
+	Status           OrderStatus
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	PaidAt           *time.Time
+	CancelledAt      *time.Time
+	PaymentStartedAt *time.Time
+	Version          uint64 // Set by the repository and incremented on every write
 }
@@ -0,0 +1,34 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OrderFilter selects orders, zero values of the fields match any order
+type OrderFilter struct {
+	Status        OrderStatus
+	UserUUID      uuid.UUID
+	PaymentMethod PaymentMethod // Unpaid orders never match a payment method
+	CreatedFrom   time.Time     // Inclusive lower bound of creation time
+	CreatedTo     time.Time     // Exclusive upper bound of creation time
+}
+
+// Matches checks if the order satisfies all conditions of the filter
+func (f OrderFilter) Matches(order Order) bool {
+	switch {
+	case f.Status != "" && order.Status != f.Status:
+		return false
+	case f.UserUUID != uuid.Nil && order.UserUUID != f.UserUUID:
+		return false
+	case f.PaymentMethod != "" && (order.PaymentInfo == nil || order.PaymentInfo.PaymentMethod != f.PaymentMethod):
+		return false
+	case !f.CreatedFrom.IsZero() && order.CreatedAt.Before(f.CreatedFrom):
+		return false
+	case !f.CreatedTo.IsZero() && !order.CreatedAt.Before(f.CreatedTo):
+		return false
+	default:
+		return true
+	}
+}
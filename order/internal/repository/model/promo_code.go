@@ -0,0 +1,52 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DiscountType string
+
+// Valid DiscountType values
+const (
+	DiscountTypePercent DiscountType = "PERCENT"
+	DiscountTypeFixed   DiscountType = "FIXED"
+)
+
+// IsValid checks if the DiscountType has a valid value
+func (dt DiscountType) IsValid() bool {
+	switch dt {
+	case DiscountTypePercent, DiscountTypeFixed:
+		return true
+	default:
+		return false
+	}
+}
+
+// PromoCode describes a discount rule and restrictions of its usage
+// Zero values of optional restrictions mean "no restriction"
+type PromoCode struct {
+	Code           string
+	DiscountType   DiscountType
+	Value          float64   // Percent in (0, 100] or fixed amount
+	Categories     []string  // Part categories the discount applies to, all parts if empty
+	MinOrderValue  float64   // Minimum subtotal of the order
+	ValidFrom      time.Time // Code can't be used before this moment
+	ExpiresAt      time.Time // Code can't be used after this moment
+	MaxUses        int       // Total number of redemptions, 1 for one-time codes
+	MaxUsesPerUser int       // Number of redemptions by a single user
+}
+
+// Redemption records usage of a promo code by an order
+type Redemption struct {
+	Code      string
+	UserUUID  uuid.UUID
+	OrderUUID uuid.UUID
+}
+
+// NormalizePromoCode brings promo code to canonical form, codes are case-insensitive
+func NormalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
@@ -0,0 +1,36 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// QuoteItem is a priced part of the quote
+type QuoteItem struct {
+	PartUUID uuid.UUID
+	Name     string
+	Category string
+	Price    float64
+}
+
+// Quote is a price preview of an order
+// Prices of the items are honored by an order created from the quote until it expires
+// A quote can be used by a single order only
+type Quote struct {
+	QuoteUUID            uuid.UUID
+	UserUUID             uuid.UUID
+	PartUUIDs            []uuid.UUID // Requested parts in order of the request
+	Items                []QuoteItem // Available parts in order of the request
+	UnavailablePartUUIDs []uuid.UUID // Parts that were not found or are out of stock
+	SubtotalPrice        float64
+	Discount             *Discount
+	TotalPrice           float64
+	ExpiresAt            time.Time
+	OrderUUID            uuid.UUID // Order created from the quote, uuid.Nil until the quote is used
+}
+
+// IsExpired checks if the quote can't be used at the given moment anymore
+func (q Quote) IsExpired(now time.Time) bool {
+	return !now.Before(q.ExpiresAt)
+}
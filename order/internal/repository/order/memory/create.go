@@ -25,6 +25,8 @@ func (r *ordersRepository) AddOrder(ctx context.Context, order model.Order) erro
 
 	// Store a copy of the order to prevent external modifications
 	orderCopy := order
+	orderCopy.Version = 1
 	r.orders[order.OrderUUID] = &orderCopy
+	r.indexAdd(&orderCopy)
 	return nil
 }
@@ -13,10 +13,12 @@ func (r *ordersRepository) DeleteOrder(ctx context.Context, uuid uuid.UUID) erro
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	if _, exists := r.orders[uuid]; !exists {
+	order, exists := r.orders[uuid]
+	if !exists {
 		return repository.ErrOrderNotFoundWith(uuid)
 	}
 
+	r.indexRemove(order)
 	delete(r.orders, uuid)
 	return nil
 }
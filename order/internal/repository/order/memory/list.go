@@ -0,0 +1,77 @@
+package memory
+
+import (
+	"context"
+	"slices"
+
+	"github.com/andredubov/rocket-factory/order/internal/repository/model"
+)
+
+// forEachBatchSize is the number of index keys examined per read lock acquisition
+const forEachBatchSize = 256
+
+// ForEachOrder calls fn for every order matching the filter ordered by creation time.
+// Orders are read in batches of keys following the last examined one in the creation index.
+// The read lock is held only while a batch is copied and fn is called without it,
+// so memory used by iteration doesn't grow with the number of orders and slow consumers
+// never block writers. Orders added or removed during iteration may be missed.
+func (r *ordersRepository) ForEachOrder(ctx context.Context, filter model.OrderFilter, fn func(model.Order) error) error {
+	batch := make([]model.Order, 0, forEachBatchSize)
+	var after *orderKey // Last examined key, nil before the first batch
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var (
+			last orderKey
+			more bool
+		)
+		batch, last, more = r.nextBatch(after, filter, batch[:0])
+
+		for _, order := range batch {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(order); err != nil {
+				return err
+			}
+		}
+
+		if !more {
+			return nil
+		}
+		after = &last
+	}
+}
+
+// nextBatch appends copies of orders matching the filter among up to forEachBatchSize keys
+// following after (from the first key if after is nil) to batch.
+// Returns the batch, the last examined key and whether any keys follow it.
+func (r *ordersRepository) nextBatch(after *orderKey, filter model.OrderFilter, batch []model.Order) ([]model.Order, orderKey, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	start := 0
+	if after != nil {
+		i, found := slices.BinarySearchFunc(r.byCreation, *after, compareKeys)
+		if found {
+			i++
+		}
+		start = i
+	}
+
+	end := min(start+forEachBatchSize, len(r.byCreation))
+	if start == end {
+		return batch, orderKey{}, false
+	}
+
+	for _, key := range r.byCreation[start:end] {
+		if order := r.orders[key.orderUUID]; filter.Matches(*order) {
+			batch = append(batch, *order)
+		}
+	}
+
+	return batch, r.byCreation[end-1], end < len(r.byCreation)
+}
@@ -0,0 +1,11 @@
+package memory
+
+import (
+	"context"
+)
+
+// Ping checks that the repository is able to serve requests.
+// In-memory storage is always available while the process is alive.
+func (r *ordersRepository) Ping(ctx context.Context) error {
+	return ctx.Err()
+}
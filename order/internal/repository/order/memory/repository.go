@@ -1,7 +1,10 @@
 package memory
 
 import (
+	"slices"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -11,9 +14,11 @@ import (
 
 // ordersRepository is an in-memory implementation of the Orders repository.
 // It uses a sync.RWMutex for concurrent access protection and a map for storage.
+// Keys of all orders are also kept sorted by creation time, so orders can be streamed in batches.
 type ordersRepository struct {
-	mu     sync.RWMutex               // Guards access to the orders map
-	orders map[uuid.UUID]*model.Order // Map storing orders by their UUID
+	mu         sync.RWMutex               // Guards access to the orders map and the creation index
+	orders     map[uuid.UUID]*model.Order // Map storing orders by their UUID
+	byCreation []orderKey                 // Keys of all orders sorted by creation time
 }
 
 // NewOrderRepository creates a new instance of an in-memory order repository.
@@ -23,3 +28,36 @@ func NewOrderRepository() repository.Orders {
 		orders: make(map[uuid.UUID]*model.Order), // Initialize empty orders map
 	}
 }
+
+// orderKey orders orders by creation time, ties are broken by UUID to keep the order stable
+type orderKey struct {
+	createdAt time.Time
+	orderUUID uuid.UUID
+}
+
+func keyOf(order *model.Order) orderKey {
+	return orderKey{createdAt: order.CreatedAt, orderUUID: order.OrderUUID}
+}
+
+func compareKeys(a, b orderKey) int {
+	if c := a.createdAt.Compare(b.createdAt); c != 0 {
+		return c
+	}
+	return strings.Compare(a.orderUUID.String(), b.orderUUID.String())
+}
+
+// indexAdd adds the order to the creation index.
+// Caller must hold the write lock.
+func (r *ordersRepository) indexAdd(order *model.Order) {
+	key := keyOf(order)
+	i, _ := slices.BinarySearchFunc(r.byCreation, key, compareKeys)
+	r.byCreation = slices.Insert(r.byCreation, i, key)
+}
+
+// indexRemove removes the order from the creation index.
+// Caller must hold the write lock.
+func (r *ordersRepository) indexRemove(order *model.Order) {
+	if i, found := slices.BinarySearchFunc(r.byCreation, keyOf(order), compareKeys); found {
+		r.byCreation = slices.Delete(r.byCreation, i, i+1)
+	}
+}
@@ -19,12 +19,50 @@ func (r *ordersRepository) UpdateOrder(ctx context.Context, order model.Order) e
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	if _, exists := r.orders[order.OrderUUID]; !exists {
+	stored, exists := r.orders[order.OrderUUID]
+	if !exists {
 		return repository.ErrOrderNotFoundWith(order.OrderUUID)
 	}
 
+	r.store(order, stored)
+	return nil
+}
+
+// UpdateOrderIf modifies an existing order if it wasn't modified since the given version was read.
+// The version is checked and the order is replaced under the same lock.
+func (r *ordersRepository) UpdateOrderIf(ctx context.Context, order model.Order, version uint64) error {
+	if !order.Status.IsValid() {
+		return repository.ErrInvalidOrderStatusWith(order.Status)
+	}
+	if order.PaymentInfo != nil && !order.PaymentInfo.PaymentMethod.IsValid() {
+		return repository.ErrInvalidPaymentMethodWith(order.PaymentInfo.PaymentMethod)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.orders[order.OrderUUID]
+	if !exists {
+		return repository.ErrOrderNotFoundWith(order.OrderUUID)
+	}
+	if stored.Version != version {
+		return repository.ErrOrderModifiedWith(order.OrderUUID)
+	}
+
+	r.store(order, stored)
+	return nil
+}
+
+// store replaces the stored order with a copy of the next version.
+// Caller must hold the write lock.
+func (r *ordersRepository) store(order model.Order, stored *model.Order) {
 	// Store a copy of the order to prevent external modifications
 	orderCopy := order
+	orderCopy.Version = stored.Version + 1
 	r.orders[order.OrderUUID] = &orderCopy
-	return nil
+
+	if !orderCopy.CreatedAt.Equal(stored.CreatedAt) {
+		r.indexRemove(stored)
+		r.indexAdd(&orderCopy)
+	}
 }
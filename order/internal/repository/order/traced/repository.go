@@ -0,0 +1,112 @@
+package traced
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/andredubov/rocket-factory/order/internal/repository"
+	"github.com/andredubov/rocket-factory/order/internal/repository/model"
+	"github.com/andredubov/rocket-factory/shared/pkg/tracing"
+)
+
+const tracerName = "github.com/andredubov/rocket-factory/order/internal/repository"
+
+// ordersRepository wraps another Orders implementation and records a span per call
+type ordersRepository struct {
+	next   repository.Orders
+	tracer trace.Tracer
+}
+
+// NewOrderRepository creates tracing decorator around the given orders repository.
+// Returns an implementation of the repository.Orders interface.
+func NewOrderRepository(next repository.Orders) repository.Orders {
+	return &ordersRepository{
+		next:   next,
+		tracer: tracing.Tracer(tracerName),
+	}
+}
+
+// GetOrder retrieves a single order by its UUID.
+func (r *ordersRepository) GetOrder(ctx context.Context, orderUUID uuid.UUID) (order *model.Order, err error) {
+	ctx, span := r.tracer.Start(ctx, "OrdersRepository.GetOrder",
+		trace.WithAttributes(attribute.String("order.uuid", orderUUID.String())))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.GetOrder(ctx, orderUUID)
+}
+
+// AddOrder stores a new order.
+func (r *ordersRepository) AddOrder(ctx context.Context, order model.Order) (err error) {
+	ctx, span := r.tracer.Start(ctx, "OrdersRepository.AddOrder",
+		trace.WithAttributes(attribute.String("order.uuid", order.OrderUUID.String())))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.AddOrder(ctx, order)
+}
+
+// UpdateOrder replaces an existing order.
+func (r *ordersRepository) UpdateOrder(ctx context.Context, order model.Order) (err error) {
+	ctx, span := r.tracer.Start(ctx, "OrdersRepository.UpdateOrder",
+		trace.WithAttributes(attribute.String("order.uuid", order.OrderUUID.String())))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.UpdateOrder(ctx, order)
+}
+
+// UpdateOrderIf replaces an existing order unless it was modified since the given version.
+func (r *ordersRepository) UpdateOrderIf(ctx context.Context, order model.Order, version uint64) (err error) {
+	ctx, span := r.tracer.Start(ctx, "OrdersRepository.UpdateOrderIf",
+		trace.WithAttributes(
+			attribute.String("order.uuid", order.OrderUUID.String()),
+			attribute.Int64("order.version", int64(version)), // #nosec G115 -- versions are far below MaxInt64
+		))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.UpdateOrderIf(ctx, order, version)
+}
+
+// DeleteOrder removes an order by its UUID.
+func (r *ordersRepository) DeleteOrder(ctx context.Context, orderUUID uuid.UUID) (err error) {
+	ctx, span := r.tracer.Start(ctx, "OrdersRepository.DeleteOrder",
+		trace.WithAttributes(attribute.String("order.uuid", orderUUID.String())))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.DeleteOrder(ctx, orderUUID)
+}
+
+// GetUserOrders retrieves all orders belonging to a specific user.
+func (r *ordersRepository) GetUserOrders(ctx context.Context, userUUID uuid.UUID) (orders []model.Order, err error) {
+	ctx, span := r.tracer.Start(ctx, "OrdersRepository.GetUserOrders",
+		trace.WithAttributes(attribute.String("user.uuid", userUUID.String())))
+	defer func() {
+		span.SetAttributes(attribute.Int("orders.count", len(orders)))
+		tracing.End(span, err)
+	}()
+
+	return r.next.GetUserOrders(ctx, userUUID)
+}
+
+// ForEachOrder streams orders matching the filter.
+// The span covers the whole iteration including time spent in fn.
+func (r *ordersRepository) ForEachOrder(ctx context.Context, filter model.OrderFilter, fn func(model.Order) error) (err error) {
+	ctx, span := r.tracer.Start(ctx, "OrdersRepository.ForEachOrder",
+		trace.WithAttributes(attribute.String("order.status", string(filter.Status))))
+	count := 0
+	defer func() {
+		span.SetAttributes(attribute.Int("orders.count", count))
+		tracing.End(span, err)
+	}()
+
+	return r.next.ForEachOrder(ctx, filter, func(order model.Order) error {
+		count++
+		return fn(order)
+	})
+}
+
+// Ping is not traced, it is called by readiness probes only.
+func (r *ordersRepository) Ping(ctx context.Context) error {
+	return r.next.Ping(ctx)
+}
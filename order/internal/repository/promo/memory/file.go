@@ -0,0 +1,44 @@
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/andredubov/rocket-factory/order/internal/repository/model"
+)
+
+// promoCodeRecord is a promo code as it is stored in the seed file
+type promoCodeRecord struct {
+	Code           string             `json:"code"`
+	DiscountType   model.DiscountType `json:"discount_type"`
+	Value          float64            `json:"value"`
+	Categories     []string           `json:"categories"`
+	MinOrderValue  float64            `json:"min_order_value"`
+	ValidFrom      time.Time          `json:"valid_from"`
+	ExpiresAt      time.Time          `json:"expires_at"`
+	MaxUses        int                `json:"max_uses"`
+	MaxUsesPerUser int                `json:"max_uses_per_user"`
+}
+
+// LoadPromoCodes reads promo codes from a JSON seed file.
+// Timestamps are expected in RFC 3339 format, omitted fields mean "no restriction".
+func LoadPromoCodes(path string) ([]model.PromoCode, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- path comes from service configuration
+	if err != nil {
+		return nil, fmt.Errorf("failed to read promo codes file: %w", err)
+	}
+
+	var records []promoCodeRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("failed to parse promo codes file %s: %w", path, err)
+	}
+
+	codes := make([]model.PromoCode, 0, len(records))
+	for _, record := range records {
+		codes = append(codes, model.PromoCode(record))
+	}
+
+	return codes, nil
+}
@@ -0,0 +1,26 @@
+package memory
+
+import (
+	"context"
+	"slices"
+
+	"github.com/andredubov/rocket-factory/order/internal/repository"
+	"github.com/andredubov/rocket-factory/order/internal/repository/model"
+)
+
+// GetPromoCode retrieves a promo code, lookup is case-insensitive.
+func (r *promoCodesRepository) GetPromoCode(ctx context.Context, code string) (*model.PromoCode, error) {
+	code = model.NormalizePromoCode(code)
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	promo, exists := r.codes[code]
+	if !exists {
+		return nil, repository.ErrPromoCodeNotFoundWith(code)
+	}
+
+	// Return a copy to prevent external modifications
+	promo.Categories = slices.Clone(promo.Categories)
+	return &promo, nil
+}
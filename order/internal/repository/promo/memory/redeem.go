@@ -0,0 +1,66 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/andredubov/rocket-factory/order/internal/repository"
+	"github.com/andredubov/rocket-factory/order/internal/repository/model"
+)
+
+// RedeemPromoCode records usage of a promo code by an order.
+// Usage limits are checked and updated atomically, so concurrent orders
+// can't redeem a code more times than allowed.
+// Redeeming the code again for the same order is a no-op.
+func (r *promoCodesRepository) RedeemPromoCode(ctx context.Context, redemption model.Redemption) error {
+	redemption.Code = model.NormalizePromoCode(redemption.Code)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	promo, exists := r.codes[redemption.Code]
+	if !exists {
+		return repository.ErrPromoCodeNotFoundWith(redemption.Code)
+	}
+
+	redemptions := r.redemptions[redemption.Code]
+	if _, exists := redemptions[redemption.OrderUUID]; exists {
+		return nil
+	}
+
+	if promo.MaxUses > 0 && len(redemptions) >= promo.MaxUses {
+		return repository.ErrPromoCodeExhaustedWith(redemption.Code)
+	}
+
+	if promo.MaxUsesPerUser > 0 {
+		userUses := 0
+		for _, existing := range redemptions {
+			if existing.UserUUID == redemption.UserUUID {
+				userUses++
+			}
+		}
+		if userUses >= promo.MaxUsesPerUser {
+			return repository.ErrPromoCodeUserLimitWith(redemption.Code)
+		}
+	}
+
+	redemptions[redemption.OrderUUID] = redemption
+	return nil
+}
+
+// ReleasePromoCode returns usage of a promo code taken by an order,
+// e.g. when the order was not saved or has been cancelled.
+// Releasing a code that was not redeemed by the order is a no-op.
+func (r *promoCodesRepository) ReleasePromoCode(ctx context.Context, redemption model.Redemption) error {
+	redemption.Code = model.NormalizePromoCode(redemption.Code)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	redemptions, exists := r.redemptions[redemption.Code]
+	if !exists {
+		return repository.ErrPromoCodeNotFoundWith(redemption.Code)
+	}
+
+	delete(redemptions, redemption.OrderUUID)
+	return nil
+}
@@ -0,0 +1,63 @@
+package memory
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/andredubov/rocket-factory/order/internal/repository"
+	"github.com/andredubov/rocket-factory/order/internal/repository/model"
+)
+
+// promoCodesRepository is an in-memory implementation of the PromoCodes repository.
+// It keeps promo codes by their normalized value and redemptions of every code by order UUID.
+type promoCodesRepository struct {
+	mu          sync.RWMutex                              // Guards access to the maps
+	codes       map[string]model.PromoCode                // Promo codes by normalized code
+	redemptions map[string]map[uuid.UUID]model.Redemption // Redemptions of every code by order UUID
+}
+
+// NewPromoCodeRepository creates a new instance of an in-memory promo code repository
+// seeded with the given codes. Codes are validated and normalized.
+// Returns an implementation of the repository.PromoCodes interface.
+func NewPromoCodeRepository(codes []model.PromoCode) (repository.PromoCodes, error) {
+	r := &promoCodesRepository{
+		codes:       make(map[string]model.PromoCode, len(codes)),
+		redemptions: make(map[string]map[uuid.UUID]model.Redemption, len(codes)),
+	}
+
+	for _, promo := range codes {
+		promo.Code = model.NormalizePromoCode(promo.Code)
+		if err := validate(promo); err != nil {
+			return nil, err
+		}
+		if _, exists := r.codes[promo.Code]; exists {
+			return nil, repository.ErrInvalidPromoCodeWith(promo.Code, "duplicate code")
+		}
+
+		r.codes[promo.Code] = promo
+		r.redemptions[promo.Code] = make(map[uuid.UUID]model.Redemption)
+	}
+
+	return r, nil
+}
+
+// validate checks that the promo code rule is consistent
+func validate(promo model.PromoCode) error {
+	switch {
+	case promo.Code == "":
+		return repository.ErrInvalidPromoCodeWith(promo.Code, "empty code")
+	case !promo.DiscountType.IsValid():
+		return repository.ErrInvalidPromoCodeWith(promo.Code, "unknown discount type "+string(promo.DiscountType))
+	case promo.Value <= 0:
+		return repository.ErrInvalidPromoCodeWith(promo.Code, "discount value must be positive")
+	case promo.DiscountType == model.DiscountTypePercent && promo.Value > 100:
+		return repository.ErrInvalidPromoCodeWith(promo.Code, "percent discount can't exceed 100")
+	case promo.MinOrderValue < 0, promo.MaxUses < 0, promo.MaxUsesPerUser < 0:
+		return repository.ErrInvalidPromoCodeWith(promo.Code, "limits can't be negative")
+	case !promo.ExpiresAt.IsZero() && promo.ExpiresAt.Before(promo.ValidFrom):
+		return repository.ErrInvalidPromoCodeWith(promo.Code, "code expires before it becomes valid")
+	default:
+		return nil
+	}
+}
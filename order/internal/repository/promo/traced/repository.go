@@ -0,0 +1,65 @@
+package traced
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/andredubov/rocket-factory/order/internal/repository"
+	"github.com/andredubov/rocket-factory/order/internal/repository/model"
+	"github.com/andredubov/rocket-factory/shared/pkg/tracing"
+)
+
+const tracerName = "github.com/andredubov/rocket-factory/order/internal/repository"
+
+// promoCodesRepository wraps another PromoCodes implementation and records a span per call
+type promoCodesRepository struct {
+	next   repository.PromoCodes
+	tracer trace.Tracer
+}
+
+// NewPromoCodeRepository creates tracing decorator around the given promo code repository.
+// Returns an implementation of the repository.PromoCodes interface.
+func NewPromoCodeRepository(next repository.PromoCodes) repository.PromoCodes {
+	return &promoCodesRepository{
+		next:   next,
+		tracer: tracing.Tracer(tracerName),
+	}
+}
+
+// GetPromoCode retrieves a promo code.
+func (r *promoCodesRepository) GetPromoCode(ctx context.Context, code string) (promo *model.PromoCode, err error) {
+	ctx, span := r.tracer.Start(ctx, "PromoCodesRepository.GetPromoCode",
+		trace.WithAttributes(attribute.String("promo.code", code)))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.GetPromoCode(ctx, code)
+}
+
+// RedeemPromoCode records usage of a promo code by an order.
+func (r *promoCodesRepository) RedeemPromoCode(ctx context.Context, redemption model.Redemption) (err error) {
+	ctx, span := r.tracer.Start(ctx, "PromoCodesRepository.RedeemPromoCode",
+		trace.WithAttributes(redemptionAttributes(redemption)...))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.RedeemPromoCode(ctx, redemption)
+}
+
+// ReleasePromoCode returns usage of a promo code taken by an order.
+func (r *promoCodesRepository) ReleasePromoCode(ctx context.Context, redemption model.Redemption) (err error) {
+	ctx, span := r.tracer.Start(ctx, "PromoCodesRepository.ReleasePromoCode",
+		trace.WithAttributes(redemptionAttributes(redemption)...))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.ReleasePromoCode(ctx, redemption)
+}
+
+// redemptionAttributes describes redemption in span attributes
+func redemptionAttributes(redemption model.Redemption) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("promo.code", redemption.Code),
+		attribute.String("order.uuid", redemption.OrderUUID.String()),
+		attribute.String("user.uuid", redemption.UserUUID.String()),
+	}
+}
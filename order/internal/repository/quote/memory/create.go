@@ -0,0 +1,31 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/andredubov/rocket-factory/order/internal/repository"
+	"github.com/andredubov/rocket-factory/order/internal/repository/model"
+)
+
+// AddQuote adds a new quote to the repository.
+// Quotes that have already expired are evicted to keep memory bounded.
+func (r *quotesRepository) AddQuote(ctx context.Context, quote model.Quote) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.quotes[quote.QuoteUUID]; exists {
+		return repository.ErrQuoteAlreadyExistsWith(quote.QuoteUUID)
+	}
+
+	now := r.clock.Now()
+	for quoteUUID, stored := range r.quotes {
+		if stored.IsExpired(now) {
+			delete(r.quotes, quoteUUID)
+		}
+	}
+
+	// Store a copy of the quote to prevent external modifications
+	quoteCopy := quote
+	r.quotes[quote.QuoteUUID] = &quoteCopy
+	return nil
+}
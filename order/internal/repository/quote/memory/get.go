@@ -0,0 +1,26 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/andredubov/rocket-factory/order/internal/repository"
+	"github.com/andredubov/rocket-factory/order/internal/repository/model"
+)
+
+// GetQuote retrieves a single quote by its UUID.
+// Expired quotes are returned until they are evicted, callers check expiration themselves.
+func (r *quotesRepository) GetQuote(ctx context.Context, uuid uuid.UUID) (*model.Quote, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	quote, exists := r.quotes[uuid]
+	if !exists {
+		return nil, repository.ErrQuoteNotFoundWith(uuid)
+	}
+
+	// Return a copy to prevent external modifications
+	quoteCopy := *quote
+	return &quoteCopy, nil
+}
@@ -0,0 +1,28 @@
+package memory
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/andredubov/rocket-factory/order/internal/clock"
+	"github.com/andredubov/rocket-factory/order/internal/repository"
+	"github.com/andredubov/rocket-factory/order/internal/repository/model"
+)
+
+// quotesRepository is an in-memory implementation of the Quotes repository.
+// It uses a sync.RWMutex for concurrent access protection and a map for storage.
+type quotesRepository struct {
+	mu     sync.RWMutex               // Guards access to the quotes map
+	quotes map[uuid.UUID]*model.Quote // Map storing quotes by their UUID
+	clock  clock.Clock                // Decides which quotes have expired
+}
+
+// NewQuoteRepository creates a new instance of an in-memory quote repository.
+// Returns an implementation of the repository.Quotes interface.
+func NewQuoteRepository(clock clock.Clock) repository.Quotes {
+	return &quotesRepository{
+		quotes: make(map[uuid.UUID]*model.Quote), // Initialize empty quotes map
+		clock:  clock,
+	}
+}
@@ -0,0 +1,49 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/andredubov/rocket-factory/order/internal/repository"
+)
+
+// UseQuote marks the quote as used by an order.
+// The quote is checked and marked atomically, so concurrent orders can't use the same quote.
+// Using the quote again for the same order is a no-op.
+func (r *quotesRepository) UseQuote(ctx context.Context, quoteUUID, orderUUID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	quote, exists := r.quotes[quoteUUID]
+	if !exists {
+		return repository.ErrQuoteNotFoundWith(quoteUUID)
+	}
+
+	switch quote.OrderUUID {
+	case orderUUID:
+		return nil
+	case uuid.Nil:
+		quote.OrderUUID = orderUUID
+		return nil
+	default:
+		return repository.ErrQuoteUsedWith(quoteUUID)
+	}
+}
+
+// ReleaseQuote makes the quote used by an order available again, e.g. when the order was not saved.
+// Releasing a quote that is not used by the order is a no-op.
+func (r *quotesRepository) ReleaseQuote(ctx context.Context, quoteUUID, orderUUID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	quote, exists := r.quotes[quoteUUID]
+	if !exists {
+		return repository.ErrQuoteNotFoundWith(quoteUUID)
+	}
+
+	if quote.OrderUUID == orderUUID {
+		quote.OrderUUID = uuid.Nil
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package traced
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/andredubov/rocket-factory/order/internal/repository"
+	"github.com/andredubov/rocket-factory/order/internal/repository/model"
+	"github.com/andredubov/rocket-factory/shared/pkg/tracing"
+)
+
+const tracerName = "github.com/andredubov/rocket-factory/order/internal/repository"
+
+// quotesRepository wraps another Quotes implementation and records a span per call
+type quotesRepository struct {
+	next   repository.Quotes
+	tracer trace.Tracer
+}
+
+// NewQuoteRepository creates tracing decorator around the given quote repository.
+// Returns an implementation of the repository.Quotes interface.
+func NewQuoteRepository(next repository.Quotes) repository.Quotes {
+	return &quotesRepository{
+		next:   next,
+		tracer: tracing.Tracer(tracerName),
+	}
+}
+
+// GetQuote retrieves a single quote by its UUID.
+func (r *quotesRepository) GetQuote(ctx context.Context, quoteUUID uuid.UUID) (quote *model.Quote, err error) {
+	ctx, span := r.tracer.Start(ctx, "QuotesRepository.GetQuote",
+		trace.WithAttributes(attribute.String("quote.uuid", quoteUUID.String())))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.GetQuote(ctx, quoteUUID)
+}
+
+// AddQuote stores a new quote.
+func (r *quotesRepository) AddQuote(ctx context.Context, quote model.Quote) (err error) {
+	ctx, span := r.tracer.Start(ctx, "QuotesRepository.AddQuote",
+		trace.WithAttributes(attribute.String("quote.uuid", quote.QuoteUUID.String())))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.AddQuote(ctx, quote)
+}
+
+// UseQuote marks the quote as used by an order.
+func (r *quotesRepository) UseQuote(ctx context.Context, quoteUUID, orderUUID uuid.UUID) (err error) {
+	ctx, span := r.tracer.Start(ctx, "QuotesRepository.UseQuote",
+		trace.WithAttributes(
+			attribute.String("quote.uuid", quoteUUID.String()),
+			attribute.String("order.uuid", orderUUID.String()),
+		))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.UseQuote(ctx, quoteUUID, orderUUID)
+}
+
+// ReleaseQuote makes the quote used by an order available again.
+func (r *quotesRepository) ReleaseQuote(ctx context.Context, quoteUUID, orderUUID uuid.UUID) (err error) {
+	ctx, span := r.tracer.Start(ctx, "QuotesRepository.ReleaseQuote",
+		trace.WithAttributes(
+			attribute.String("quote.uuid", quoteUUID.String()),
+			attribute.String("order.uuid", orderUUID.String()),
+		))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.ReleaseQuote(ctx, quoteUUID, orderUUID)
+}
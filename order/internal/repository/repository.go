@@ -16,6 +16,14 @@ var (
 	ErrInvalidPaymentMethod = errors.New("invalid payment method")
 	ErrOrderAlreadyExists   = errors.New("order already exists")
 	ErrOrderNotFound        = errors.New("order not found")
+	ErrOrderModified        = errors.New("order was modified concurrently")
+	ErrInvalidPromoCode     = errors.New("invalid promo code")
+	ErrPromoCodeNotFound    = errors.New("promo code not found")
+	ErrPromoCodeExhausted   = errors.New("promo code usage limit reached")
+	ErrPromoCodeUserLimit   = errors.New("promo code usage limit per user reached")
+	ErrQuoteAlreadyExists   = errors.New("quote already exists")
+	ErrQuoteNotFound        = errors.New("quote not found")
+	ErrQuoteUsed            = errors.New("quote has already been used")
 )
 
 // Helper functions for creating formatted errors
@@ -35,11 +43,69 @@ func ErrOrderNotFoundWith(uuid uuid.UUID) error {
 	return fmt.Errorf("%w: %s", ErrOrderNotFound, uuid)
 }
 
+func ErrOrderModifiedWith(uuid uuid.UUID) error {
+	return fmt.Errorf("%w: %s", ErrOrderModified, uuid)
+}
+
+func ErrInvalidPromoCodeWith(code, reason string) error {
+	return fmt.Errorf("%w %s: %s", ErrInvalidPromoCode, code, reason)
+}
+
+func ErrPromoCodeNotFoundWith(code string) error {
+	return fmt.Errorf("%w: %s", ErrPromoCodeNotFound, code)
+}
+
+func ErrPromoCodeExhaustedWith(code string) error {
+	return fmt.Errorf("%w: %s", ErrPromoCodeExhausted, code)
+}
+
+func ErrPromoCodeUserLimitWith(code string) error {
+	return fmt.Errorf("%w: %s", ErrPromoCodeUserLimit, code)
+}
+
+func ErrQuoteAlreadyExistsWith(uuid uuid.UUID) error {
+	return fmt.Errorf("%w: %s", ErrQuoteAlreadyExists, uuid)
+}
+
+func ErrQuoteNotFoundWith(uuid uuid.UUID) error {
+	return fmt.Errorf("%w: %s", ErrQuoteNotFound, uuid)
+}
+
+func ErrQuoteUsedWith(uuid uuid.UUID) error {
+	return fmt.Errorf("%w: %s", ErrQuoteUsed, uuid)
+}
+
 // Orders defines the interface for order repository operations.
 type Orders interface {
 	GetOrder(ctx context.Context, uuid uuid.UUID) (*model.Order, error)
 	AddOrder(ctx context.Context, order model.Order) error
 	UpdateOrder(ctx context.Context, order model.Order) error
+	// UpdateOrderIf replaces the order only if its stored version is still the given one,
+	// otherwise it returns ErrOrderModified.
+	UpdateOrderIf(ctx context.Context, order model.Order, version uint64) error
 	DeleteOrder(ctx context.Context, uuid uuid.UUID) error
 	GetUserOrders(ctx context.Context, userUUID uuid.UUID) ([]model.Order, error)
+	// ForEachOrder calls fn for every order matching the filter ordered by creation time.
+	// Orders are passed one by one, so callers can stream them without loading all orders.
+	// Iteration stops at the first error returned by fn or on context cancellation.
+	ForEachOrder(ctx context.Context, filter model.OrderFilter, fn func(model.Order) error) error
+	Ping(ctx context.Context) error
+}
+
+// PromoCodes defines the interface for promo code repository operations.
+// Redemptions are counted against usage limits of the code.
+type PromoCodes interface {
+	GetPromoCode(ctx context.Context, code string) (*model.PromoCode, error)
+	RedeemPromoCode(ctx context.Context, redemption model.Redemption) error
+	ReleasePromoCode(ctx context.Context, redemption model.Redemption) error
+}
+
+// Quotes defines the interface for order quote repository operations.
+// Expired quotes may be evicted by the implementation.
+// A quote is used by a single order, a used quote can't be used by another order until released.
+type Quotes interface {
+	GetQuote(ctx context.Context, uuid uuid.UUID) (*model.Quote, error)
+	AddQuote(ctx context.Context, quote model.Quote) error
+	UseQuote(ctx context.Context, quoteUUID, orderUUID uuid.UUID) error
+	ReleaseQuote(ctx context.Context, quoteUUID, orderUUID uuid.UUID) error
 }
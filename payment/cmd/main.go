@@ -2,9 +2,9 @@ package main
 
 import (
 	"context"
-	"log"
 
 	"github.com/andredubov/rocket-factory/payment/internal/app"
+	"github.com/andredubov/rocket-factory/shared/pkg/logger"
 )
 
 func main() {
@@ -12,11 +12,11 @@ func main() {
 
 	application, err := app.NewApp(ctx)
 	if err != nil {
-		log.Fatalf("failed to init app: %s", err.Error())
+		logger.Fatal("failed to init app", "error", err)
 	}
 
 	err = application.Run()
 	if err != nil {
-		log.Fatalf("failed to run app: %s", err.Error())
+		logger.Fatal("failed to run app", "error", err)
 	}
 }
@@ -2,7 +2,7 @@ package server
 
 import (
 	"context"
-	"log"
+	"log/slog"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -19,12 +19,15 @@ func (i *PaymentImplementation) PayOrder(ctx context.Context, req *payment_v1.Pa
 	// Create payment through domain service
 	uuid, err := i.paymentService.Create(ctx, payment)
 	if err != nil {
-		log.Printf("payment creation failed: %v", err)
+		slog.ErrorContext(ctx, "payment creation failed", "order_uuid", req.GetOrderUuid(), "error", err)
+		i.metrics.PaymentFailed(req.GetPaymentMethod())
 		return nil, status.Errorf(codes.Internal, "payment creation failed: %v", err)
 	}
 
 	// Log successful transaction
-	log.Printf("Оплата прошла успешно, transaction_uuid: %s\n", uuid)
+	slog.InfoContext(ctx, "payment succeeded",
+		"order_uuid", req.GetOrderUuid(), "transaction_uuid", uuid, "amount", payment.Amount)
+	i.metrics.PaymentSucceeded(req.GetPaymentMethod())
 
 	// Return response with transaction ID
 	return &payment_v1.PayOrderResponse{
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"github.com/andredubov/rocket-factory/payment/internal/metrics"
 	"github.com/andredubov/rocket-factory/payment/internal/service"
 	payment_v1 "github.com/andredubov/rocket-factory/shared/pkg/proto/payment/v1"
 )
@@ -9,11 +10,13 @@ import (
 type PaymentImplementation struct {
 	payment_v1.UnimplementedPaymentServiceServer
 	paymentService service.Payments
+	metrics        *metrics.Payments
 }
 
 // NewPaymentImplementation creates a new gRPC payment service handler.
-func NewPaymentImplementation(service service.Payments) *PaymentImplementation {
+func NewPaymentImplementation(service service.Payments, metrics *metrics.Payments) *PaymentImplementation {
 	return &PaymentImplementation{
 		paymentService: service,
+		metrics:        metrics,
 	}
 }
@@ -2,16 +2,32 @@ package app
 
 import (
 	"context"
-	"log"
+	"errors"
+	"log/slog"
 	"net"
+	"net/http"
+	"time"
 
-	"github.com/andredubov/golibs/pkg/closer"
 	"github.com/andredubov/golibs/pkg/config"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/andredubov/rocket-factory/shared/pkg/logger"
+	"github.com/andredubov/rocket-factory/shared/pkg/metrics"
 	payment_v1 "github.com/andredubov/rocket-factory/shared/pkg/proto/payment/v1"
+	"github.com/andredubov/rocket-factory/shared/pkg/tlsconfig"
+	"github.com/andredubov/rocket-factory/shared/pkg/tracing"
+)
+
+const (
+	// tracingShutdownTimeout limits how long buffered spans may be flushed on shutdown.
+	tracingShutdownTimeout = 5 * time.Second
+	// metricsReadHeaderTimeout protects the metrics endpoint from slow clients.
+	metricsReadHeaderTimeout = 5 * time.Second
+	// metricsShutdownTimeout limits how long an in-flight scrape may take on shutdown.
+	metricsShutdownTimeout = 5 * time.Second
 )
 
 // App is the main application structure that manages the gRPC server
@@ -19,6 +35,8 @@ import (
 type App struct {
 	serviceProvider *serviceProvider
 	grpcServer      *grpc.Server
+	healthServer    *health.Server
+	metricsServer   *http.Server
 }
 
 // NewApp creates and initializes a new App instance.
@@ -32,15 +50,25 @@ func NewApp(ctx context.Context) (*App, error) {
 	return application, nil
 }
 
-// Run starts the gRPC server and handles graceful shutdown.
-// It uses the closer package to ensure proper cleanup of resources.
+// Run starts the metrics endpoint and the gRPC server and handles graceful shutdown.
+// On termination signal the server is stopped gracefully
+// and the remaining resources are closed stage by stage.
 func (a *App) Run() error {
-	defer func() {
-		closer.CloseAll() // Close all registered resources
-		closer.Wait()     // Wait for cleanup to complete
+	defer closeAll()
+
+	go a.runMetricsServer()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- a.runGRPCServer()
 	}()
 
-	return a.runGRPCServer()
+	if err := waitForSignal(errCh); err != nil {
+		return err
+	}
+
+	a.stopGRPCServer()
+	return nil
 }
 
 // initDeps initializes all application dependencies in sequence.
@@ -49,6 +77,9 @@ func (a *App) initDeps(ctx context.Context) error {
 	inits := []func(context.Context) error{
 		a.initConfig,          // Load configuration first
 		a.initServiceProvider, // Then setup service provider
+		a.initLogger,          // Install structured logger
+		a.initTracing,         // Install tracer provider
+		a.initMetricsServer,   // Configure metrics endpoint
 		a.initGRPCServer,      // Finally configure gRPC server
 	}
 
@@ -78,27 +109,104 @@ func (a *App) initServiceProvider(_ context.Context) error {
 	return nil
 }
 
+// initLogger installs the structured logger as the default one.
+func (a *App) initLogger(_ context.Context) error {
+	logger.Init(a.serviceProvider.LoggerConfig())
+	return nil
+}
+
+// initTracing installs the global tracer provider.
+// Buffered spans are flushed in the last shutdown stage.
+func (a *App) initTracing(ctx context.Context) error {
+	shutdown, err := tracing.Init(ctx, a.serviceProvider.TracingConfig())
+	if err != nil {
+		return err
+	}
+
+	addCloser(stageTelemetry, "tracer provider", func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+		defer cancel()
+
+		return shutdown(ctx)
+	})
+
+	return nil
+}
+
+// initMetricsServer configures the HTTP server exposing /metrics.
+// The server is shut down in the last shutdown stage.
+func (a *App) initMetricsServer(_ context.Context) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+
+	a.metricsServer = &http.Server{
+		Addr:              a.serviceProvider.MetricsConfig().Address(),
+		Handler:           mux,
+		ReadHeaderTimeout: metricsReadHeaderTimeout,
+	}
+
+	addCloser(stageTelemetry, "metrics server", func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), metricsShutdownTimeout)
+		defer cancel()
+
+		return a.metricsServer.Shutdown(ctx)
+	})
+
+	return nil
+}
+
 // initGRPCServer configures and initializes the gRPC server:
-// - Uses insecure credentials (for development only)
+// - Uses TLS (optionally mTLS) or insecure credentials, certificates are reloaded from disk
+// - Logs, traces and records metrics of every incoming RPC
 // - Enables server reflection (for testing)
+// - Registers the standard health service
 // - Registers the PaymentService implementation
 func (a *App) initGRPCServer(ctx context.Context) error {
+	creds, reloader, err := tlsconfig.NewServerCredentials(a.serviceProvider.TLSConfig())
+	if err != nil {
+		return err
+	}
+	if reloader != nil {
+		runWorker("certificate reloader", reloader.Watch)
+	}
+
 	opts := []grpc.ServerOption{
-		grpc.Creds(insecure.NewCredentials()), // Disable TLS for development
+		grpc.Creds(creds),      // Insecure unless GRPC_TLS_ENABLED is set
+		tracing.ServerOption(), // Continue traces started by callers
+		grpc.ChainUnaryInterceptor(
+			logger.UnaryServerInterceptor(), // Request ID is available to the handlers
+			a.serviceProvider.GRPCServerMetrics().UnaryServerInterceptor(),
+		),
 	}
 
 	a.grpcServer = grpc.NewServer(opts...)
 	reflection.Register(a.grpcServer) // Enable reflection API
+
+	// Payment service has no storage, so it is ready as soon as it is registered
+	a.healthServer = health.NewServer()
+	a.healthServer.SetServingStatus(payment_v1.PaymentService_ServiceDesc.ServiceName, grpc_health_v1.HealthCheckResponse_SERVING)
+	grpc_health_v1.RegisterHealthServer(a.grpcServer, a.healthServer)
+
 	payment_v1.RegisterPaymentServiceServer(a.grpcServer, a.serviceProvider.ServerImplementation(ctx))
 
 	return nil
 }
 
+// runMetricsServer serves /metrics on the configured address.
+func (a *App) runMetricsServer() {
+	slog.Info("metrics server starting", "address", a.metricsServer.Addr)
+
+	err := a.metricsServer.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		slog.Error("metrics server failed", "error", err)
+	}
+}
+
 // runGRPCServer starts the gRPC server on the configured address.
 // It creates a TCP listener and starts serving requests.
 func (a *App) runGRPCServer() error {
 	addr := a.serviceProvider.GRPCConfig().Address()
-	log.Printf("gRPC server starting on %s", addr)
+	slog.Info("gRPC server starting", "address", addr)
 
 	listener, err := net.Listen("tcp", addr)
 	if err != nil {
@@ -2,21 +2,38 @@ package app
 
 import (
 	"context"
-	"log"
 
 	"github.com/andredubov/golibs/pkg/config"
 	"github.com/andredubov/golibs/pkg/config/env"
+	"github.com/prometheus/client_golang/prometheus"
 
 	server "github.com/andredubov/rocket-factory/payment/internal/api/v1/payment"
+	paymentconfig "github.com/andredubov/rocket-factory/payment/internal/config"
+	paymentenv "github.com/andredubov/rocket-factory/payment/internal/config/env"
+	paymentmetrics "github.com/andredubov/rocket-factory/payment/internal/metrics"
 	"github.com/andredubov/rocket-factory/payment/internal/service"
 	"github.com/andredubov/rocket-factory/payment/internal/service/payment"
+	"github.com/andredubov/rocket-factory/shared/pkg/logger"
+	"github.com/andredubov/rocket-factory/shared/pkg/metrics"
+	"github.com/andredubov/rocket-factory/shared/pkg/tlsconfig"
+	"github.com/andredubov/rocket-factory/shared/pkg/tracing"
 )
 
+// serviceName identifies the service in logs and traces.
+const serviceName = "payment"
+
 // serviceProvider implements the dependency injection container pattern.
 // It lazily initializes and provides access to all service dependencies.
 type serviceProvider struct {
 	paymentService       service.Payments              // Business logic service
 	grpcConfig           config.GRPCConfig             // gRPC server configuration
+	loggerConfig         logger.Config                 // Log level and format
+	tracingConfig        tracing.Config                // Span sampling and export settings
+	tlsConfig            tlsconfig.ServerConfig        // Server TLS and client certificate verification
+	shutdownConfig       paymentconfig.ShutdownConfig  // Graceful stop deadline
+	metricsConfig        metrics.Config                // Metrics endpoint configuration
+	grpcServerMetrics    *metrics.GRPCServerMetrics    // gRPC RED metrics
+	paymentMetrics       *paymentmetrics.Payments      // Payment business metrics
 	serverImplementation *server.PaymentImplementation // gRPC handler implementation
 }
 
@@ -31,7 +48,7 @@ func (s *serviceProvider) GRPCConfig() config.GRPCConfig {
 	if s.grpcConfig == nil {
 		cfg, err := env.NewGRPCConfig()
 		if err != nil {
-			log.Fatalf("failed to get grpc config: %s", err.Error())
+			logger.Fatal("failed to get grpc config", "error", err)
 		}
 		s.grpcConfig = cfg
 	}
@@ -39,6 +56,95 @@ func (s *serviceProvider) GRPCConfig() config.GRPCConfig {
 	return s.grpcConfig
 }
 
+// TLSConfig loads and provides the server TLS settings.
+// Implements lazy initialization - config is loaded only once.
+func (s *serviceProvider) TLSConfig() tlsconfig.ServerConfig {
+	if s.tlsConfig == nil {
+		cfg, err := tlsconfig.NewServerEnvConfig()
+		if err != nil {
+			logger.Fatal("failed to get tls config", "error", err)
+		}
+		s.tlsConfig = cfg
+	}
+
+	return s.tlsConfig
+}
+
+// ShutdownConfig loads and provides the graceful shutdown settings.
+// Implements lazy initialization - config is loaded only once.
+func (s *serviceProvider) ShutdownConfig() paymentconfig.ShutdownConfig {
+	if s.shutdownConfig == nil {
+		cfg, err := paymentenv.NewShutdownConfig()
+		if err != nil {
+			logger.Fatal("failed to get shutdown config", "error", err)
+		}
+		s.shutdownConfig = cfg
+	}
+
+	return s.shutdownConfig
+}
+
+// LoggerConfig loads and provides the logging configuration.
+// Implements lazy initialization - config is loaded only once.
+func (s *serviceProvider) LoggerConfig() logger.Config {
+	if s.loggerConfig == nil {
+		cfg, err := logger.NewEnvConfig(serviceName)
+		if err != nil {
+			logger.Fatal("failed to get logger config", "error", err)
+		}
+		s.loggerConfig = cfg
+	}
+
+	return s.loggerConfig
+}
+
+// TracingConfig loads and provides the tracing configuration.
+// Implements lazy initialization - config is loaded only once.
+func (s *serviceProvider) TracingConfig() tracing.Config {
+	if s.tracingConfig == nil {
+		cfg, err := tracing.NewEnvConfig(serviceName)
+		if err != nil {
+			logger.Fatal("failed to get tracing config", "error", err)
+		}
+		s.tracingConfig = cfg
+	}
+
+	return s.tracingConfig
+}
+
+// MetricsConfig loads and provides the metrics endpoint configuration.
+// Implements lazy initialization - config is loaded only once.
+func (s *serviceProvider) MetricsConfig() metrics.Config {
+	if s.metricsConfig == nil {
+		cfg, err := metrics.NewEnvConfig()
+		if err != nil {
+			logger.Fatal("failed to get metrics config", "error", err)
+		}
+		s.metricsConfig = cfg
+	}
+
+	return s.metricsConfig
+}
+
+// GRPCServerMetrics provides RED metrics of handled RPCs.
+// Metrics are exported from the default prometheus registry.
+func (s *serviceProvider) GRPCServerMetrics() *metrics.GRPCServerMetrics {
+	if s.grpcServerMetrics == nil {
+		s.grpcServerMetrics = metrics.NewGRPCServerMetrics(prometheus.DefaultRegisterer)
+	}
+
+	return s.grpcServerMetrics
+}
+
+// PaymentMetrics provides payment business metrics.
+func (s *serviceProvider) PaymentMetrics() *paymentmetrics.Payments {
+	if s.paymentMetrics == nil {
+		s.paymentMetrics = paymentmetrics.NewPayments(prometheus.DefaultRegisterer)
+	}
+
+	return s.paymentMetrics
+}
+
 // PaymentService provides the payment business logic service.
 // Initializes the service only when first requested.
 func (s *serviceProvider) PaymentService(ctx context.Context) service.Payments {
@@ -50,11 +156,11 @@ func (s *serviceProvider) PaymentService(ctx context.Context) service.Payments {
 }
 
 // ServerImplementation creates and provides the gRPC server implementation.
-// It initializes all required dependencies (payment service) automatically.
+// It initializes all required dependencies (payment service and metrics) automatically.
 func (s *serviceProvider) ServerImplementation(ctx context.Context) *server.PaymentImplementation {
 	if s.serverImplementation == nil {
 		paymentService := s.PaymentService(ctx)
-		s.serverImplementation = server.NewPaymentImplementation(paymentService)
+		s.serverImplementation = server.NewPaymentImplementation(paymentService, s.PaymentMetrics())
 	}
 
 	return s.serverImplementation
@@ -0,0 +1,116 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/andredubov/golibs/pkg/closer"
+)
+
+// shutdownStage groups resources that are closed concurrently with each other.
+// Stages are closed one after another in declaration order.
+type shutdownStage int
+
+// Payment keeps no state, so there is no storage stage.
+const (
+	stageWorkers   shutdownStage = iota // Background workers stop first
+	stageTelemetry                      // Metrics endpoint and span exporter go last to observe the shutdown
+	stageCount
+)
+
+// shutdownStages holds closers of every stage.
+var shutdownStages = func() [stageCount]*closer.Closer {
+	var stages [stageCount]*closer.Closer
+	for i := range stages {
+		stages[i] = closer.New()
+	}
+	return stages
+}()
+
+// addCloser registers named resource to be closed at the given stage.
+// Errors are logged and do not stop the shutdown.
+func addCloser(stage shutdownStage, name string, f func() error) {
+	shutdownStages[stage].Add(func() error {
+		if err := f(); err != nil {
+			slog.Error("failed to close resource", "resource", name, "error", err)
+			return err
+		}
+		return nil
+	})
+}
+
+// closeAll closes registered resources stage by stage.
+// Resources registered in the global closer are closed after all stages.
+func closeAll() {
+	for _, stage := range shutdownStages {
+		stage.CloseAll()
+		stage.Wait()
+	}
+
+	closer.CloseAll()
+	closer.Wait()
+}
+
+// waitForSignal blocks until termination signal is received or errCh yields.
+func waitForSignal(errCh <-chan error) error {
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM, syscall.SIGQUIT)
+	defer signal.Stop(quit)
+
+	select {
+	case err := <-errCh:
+		return err
+	case sig := <-quit:
+		slog.Info("shutdown signal received", "signal", sig.String())
+		return nil
+	}
+}
+
+// stopGRPCServer stops GRPC server gracefully:
+// 1. Reports NOT_SERVING so that clients stop sending new RPCs
+// 2. Waits for in-flight RPCs until the configured deadline
+// 3. Cancels RPCs that are still running after the deadline
+func (a *App) stopGRPCServer() {
+	a.healthServer.Shutdown()
+
+	stopped := make(chan struct{})
+	go func() {
+		a.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	timeout := a.serviceProvider.ShutdownConfig().Timeout()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-stopped:
+		slog.Info("gRPC server stopped gracefully")
+	case <-timer.C:
+		slog.Warn("graceful stop deadline exceeded, cancelling in-flight RPCs", "timeout", timeout)
+		a.grpcServer.Stop()
+		<-stopped
+	}
+}
+
+// runWorker starts a background worker registered in the workers stage.
+// The worker context is cancelled and the worker is awaited on shutdown.
+func runWorker(name string, worker func(ctx context.Context)) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		worker(ctx)
+	}()
+
+	addCloser(stageWorkers, name, func() error {
+		cancel()
+		<-done
+		return nil
+	})
+}
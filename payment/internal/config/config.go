@@ -0,0 +1,8 @@
+package config
+
+import "time"
+
+// ShutdownConfig describes how long the server may drain in-flight RPCs on shutdown.
+type ShutdownConfig interface {
+	Timeout() time.Duration // Deadline of graceful stop, remaining RPCs are cancelled after it
+}
@@ -0,0 +1,40 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/andredubov/rocket-factory/payment/internal/config"
+)
+
+const shutdownTimeoutEnvName = "SHUTDOWN_TIMEOUT_SEC"
+
+// defaultShutdownTimeout is used when shutdown timeout is not set.
+const defaultShutdownTimeout = 30 * time.Second
+
+type shutdownConfig struct {
+	timeout time.Duration
+}
+
+// NewShutdownConfig reads graceful shutdown settings from environment variables.
+func NewShutdownConfig() (config.ShutdownConfig, error) {
+	timeout := defaultShutdownTimeout
+	if raw := os.Getenv(shutdownTimeoutEnvName); len(raw) != 0 {
+		seconds, err := strconv.Atoi(raw)
+		if err != nil || seconds <= 0 {
+			return nil, fmt.Errorf("invalid %s: %q", shutdownTimeoutEnvName, raw)
+		}
+		timeout = time.Duration(seconds) * time.Second
+	}
+
+	return &shutdownConfig{
+		timeout: timeout,
+	}, nil
+}
+
+// Timeout returns deadline of graceful stop.
+func (cfg *shutdownConfig) Timeout() time.Duration {
+	return cfg.timeout
+}
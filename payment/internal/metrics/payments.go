@@ -0,0 +1,41 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	payment_v1 "github.com/andredubov/rocket-factory/shared/pkg/proto/payment/v1"
+)
+
+const (
+	resultSuccess = "success"
+	resultFailure = "failure"
+)
+
+// Payments records outcome of payment attempts per payment method.
+type Payments struct {
+	attempts *prometheus.CounterVec
+}
+
+// NewPayments creates payment business metrics and registers them in reg.
+func NewPayments(reg prometheus.Registerer) *Payments {
+	m := &Payments{
+		attempts: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "payment",
+			Name:      "payments_total",
+			Help:      "Number of payment attempts by payment method and result.",
+		}, []string{"payment_method", "result"}),
+	}
+	reg.MustRegister(m.attempts)
+
+	return m
+}
+
+// PaymentSucceeded records a successful payment.
+func (m *Payments) PaymentSucceeded(method payment_v1.PaymentMethod) {
+	m.attempts.WithLabelValues(method.String(), resultSuccess).Inc()
+}
+
+// PaymentFailed records a failed payment.
+func (m *Payments) PaymentFailed(method payment_v1.PaymentMethod) {
+	m.attempts.WithLabelValues(method.String(), resultFailure).Inc()
+}
@@ -11,5 +11,6 @@ func PaymentFromRequest(r *payment_v1.PayOrderRequest) model.Payment {
 		UserID:        r.GetUserUuid(),
 		OrderID:       r.GetOrderUuid(),
 		PaymentMethod: model.PaymentMethod(r.GetPaymentMethod()),
+		Amount:        r.GetAmount(),
 	}
 }
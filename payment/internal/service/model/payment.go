@@ -26,4 +26,5 @@ type Payment struct {
 	UserID        string
 	OrderID       string
 	PaymentMethod PaymentMethod
+	Amount        float64 // Amount to charge
 }
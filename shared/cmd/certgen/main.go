@@ -0,0 +1,193 @@
+// Command certgen issues a local CA and certificates of the rocket factory services
+// for development and tests. Existing CA is reused, so services keep trusting
+// each other when only leaf certificates are re-issued.
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	caName    = "ca"
+	caSubject = "Rocket Factory Dev CA"
+)
+
+// service describes a certificate to issue
+type service struct {
+	name       string
+	dnsNames   []string
+	serverAuth bool
+}
+
+// services are issued certificates signed by the dev CA
+var services = []service{
+	{name: "inventory", dnsNames: []string{"localhost", "inventory", "inventory-service"}, serverAuth: true},
+	{name: "payment", dnsNames: []string{"localhost", "payment", "payment-service"}, serverAuth: true},
+	{name: "order", dnsNames: []string{"localhost", "order", "order-service"}},
+}
+
+func main() {
+	outDir := flag.String("out", "certs", "directory certificates are written to")
+	validity := flag.Duration("validity", 365*24*time.Hour, "validity period of issued certificates")
+	flag.Parse()
+
+	if err := run(*outDir, *validity); err != nil {
+		slog.Error("failed to generate certificates", "error", err)
+		os.Exit(1)
+	}
+}
+
+// run issues CA (unless it exists) and certificates of all services
+func run(outDir string, validity time.Duration) error {
+	if err := os.MkdirAll(outDir, 0o750); err != nil {
+		return err
+	}
+
+	caCert, caKey, err := loadCA(outDir)
+	if errors.Is(err, os.ErrNotExist) {
+		caCert, caKey, err = issueCA(outDir, validity)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, svc := range services {
+		if err := issue(outDir, svc, caCert, caKey, validity); err != nil {
+			return fmt.Errorf("%s: %w", svc.name, err)
+		}
+		slog.Info("certificate issued", "service", svc.name, "dir", outDir)
+	}
+
+	return nil
+}
+
+// issueCA creates self-signed CA certificate
+func issueCA(outDir string, validity time.Duration) (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template, err := newTemplate(caSubject, validity)
+	if err != nil {
+		return nil, nil, err
+	}
+	template.IsCA = true
+	template.BasicConstraintsValid = true
+	template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := write(outDir, caName, der, key); err != nil {
+		return nil, nil, err
+	}
+	slog.Info("CA issued", "dir", outDir)
+
+	cert, err := x509.ParseCertificate(der)
+	return cert, key, err
+}
+
+// issue creates certificate of the service signed by the CA
+func issue(outDir string, svc service, caCert *x509.Certificate, caKey *ecdsa.PrivateKey, validity time.Duration) error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	template, err := newTemplate(svc.name, validity)
+	if err != nil {
+		return err
+	}
+	template.KeyUsage = x509.KeyUsageDigitalSignature
+	template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	if svc.serverAuth {
+		template.ExtKeyUsage = append(template.ExtKeyUsage, x509.ExtKeyUsageServerAuth)
+	}
+	template.DNSNames = svc.dnsNames
+	template.IPAddresses = []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+	if err != nil {
+		return err
+	}
+
+	return write(outDir, svc.name, der, key)
+}
+
+// newTemplate creates certificate template with random serial number
+func newTemplate(commonName string, validity time.Duration) (*x509.Certificate, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName, Organization: []string{"Rocket Factory"}},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(validity),
+	}, nil
+}
+
+// loadCA reads CA certificate and key written by a previous run
+func loadCA(outDir string) (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	certPEM, err := os.ReadFile(filepath.Join(outDir, caName+".pem"))
+	if err != nil {
+		return nil, nil, err
+	}
+	keyPEM, err := os.ReadFile(filepath.Join(outDir, caName+"-key.pem"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	certBlock, _ := pem.Decode(certPEM)
+	keyBlock, _ := pem.Decode(keyPEM)
+	if certBlock == nil || keyBlock == nil {
+		return nil, nil, errors.New("malformed CA files")
+	}
+
+	cert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	key, err := x509.ParseECPrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cert, key, nil
+}
+
+// write stores certificate as <name>.pem and private key as <name>-key.pem
+func write(outDir, name string, der []byte, key *ecdsa.PrivateKey) error {
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	if err := os.WriteFile(filepath.Join(outDir, name+"-key.pem"), keyPEM, 0o600); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(outDir, name+".pem"), certPEM, 0o600)
+}
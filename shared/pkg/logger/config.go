@@ -0,0 +1,73 @@
+package logger
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+const (
+	levelEnvName  = "LOG_LEVEL"
+	formatEnvName = "LOG_FORMAT"
+)
+
+// Output formats supported by Init
+const (
+	FormatJSON = "json" // One JSON object per line, for log collectors
+	FormatText = "text" // key=value pairs, for local runs
+)
+
+// Config describes verbosity and format of service logs
+type Config interface {
+	ServiceName() string
+	Level() slog.Level
+	Format() string
+}
+
+type config struct {
+	serviceName string
+	level       slog.Level
+	format      string
+}
+
+// NewEnvConfig reads logging configuration of the named service from environment variables.
+// Defaults to info level and JSON output.
+func NewEnvConfig(serviceName string) (Config, error) {
+	level := slog.LevelInfo
+	if raw := os.Getenv(levelEnvName); len(raw) != 0 {
+		if err := level.UnmarshalText([]byte(raw)); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", levelEnvName, err)
+		}
+	}
+
+	format := strings.ToLower(os.Getenv(formatEnvName))
+	switch format {
+	case "":
+		format = FormatJSON
+	case FormatJSON, FormatText:
+	default:
+		return nil, fmt.Errorf("unsupported log format: %s", format)
+	}
+
+	return &config{
+		serviceName: serviceName,
+		level:       level,
+		format:      format,
+	}, nil
+}
+
+// ServiceName returns name added to every record as service attribute
+func (cfg *config) ServiceName() string {
+	return cfg.serviceName
+}
+
+// Level returns minimal level of emitted records
+func (cfg *config) Level() slog.Level {
+	return cfg.level
+}
+
+// Format returns output format
+func (cfg *config) Format() string {
+	return cfg.format
+}
@@ -0,0 +1,75 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// healthMethodPrefix is the prefix of standard health service methods
+const healthMethodPrefix = "/grpc.health.v1.Health/"
+
+// UnaryServerInterceptor takes request ID from incoming metadata (or generates a new one),
+// stores it in the handler context and logs every completed RPC
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		requestID := NewRequestID()
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(RequestIDMetadataKey); len(values) > 0 && validRequestID(values[0]) {
+				requestID = values[0]
+			}
+		}
+		ctx = WithRequestID(ctx, requestID)
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		code := status.Code(err)
+		attrs := []slog.Attr{
+			slog.String("grpc_method", info.FullMethod),
+			slog.String("grpc_code", code.String()),
+			slog.Duration("duration", time.Since(start)),
+		}
+		if err != nil {
+			attrs = append(attrs, slog.String("error", status.Convert(err).Message()))
+		}
+		level := serverCodeLevel(code)
+		if level == slog.LevelInfo && strings.HasPrefix(info.FullMethod, healthMethodPrefix) {
+			level = slog.LevelDebug // Health probes would flood the logs
+		}
+		slog.LogAttrs(ctx, level, "grpc request handled", attrs...)
+
+		return resp, err
+	}
+}
+
+// UnaryClientInterceptor passes request ID stored in ctx to the called service
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if requestID, ok := RequestIDFromContext(ctx); ok {
+			ctx = metadata.AppendToOutgoingContext(ctx, RequestIDMetadataKey, requestID)
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// serverCodeLevel maps status code to log level:
+// client mistakes are not errors of the service
+func serverCodeLevel(code codes.Code) slog.Level {
+	switch code {
+	case codes.OK:
+		return slog.LevelInfo
+	case codes.Canceled, codes.InvalidArgument, codes.NotFound, codes.AlreadyExists,
+		codes.PermissionDenied, codes.Unauthenticated, codes.FailedPrecondition, codes.OutOfRange:
+		return slog.LevelWarn
+	default:
+		return slog.LevelError
+	}
+}
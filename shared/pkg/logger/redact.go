@@ -0,0 +1,32 @@
+package logger
+
+import (
+	"log/slog"
+	"strings"
+)
+
+// redactedValue replaces values of sensitive attributes
+const redactedValue = "[REDACTED]"
+
+// sensitiveKeys are attribute names whose values never reach the logs.
+// Keys are compared case-insensitively.
+var sensitiveKeys = map[string]struct{}{
+	"authorization": {},
+	"password":      {},
+	"secret":        {},
+	"token":         {},
+	"access_token":  {},
+	"refresh_token": {},
+	"api_key":       {},
+	"card_number":   {},
+	"cvv":           {},
+}
+
+// redact hides values of sensitive attributes, including nested groups
+func redact(_ []string, attr slog.Attr) slog.Attr {
+	if _, ok := sensitiveKeys[strings.ToLower(attr.Key)]; ok {
+		return slog.String(attr.Key, redactedValue)
+	}
+
+	return attr
+}
@@ -0,0 +1,51 @@
+package logger
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// requestIDKey is the attribute name of request ID in log records
+	requestIDKey = "request_id"
+	// RequestIDHeader carries request ID in HTTP requests and responses
+	RequestIDHeader = "X-Request-ID"
+	// RequestIDMetadataKey carries request ID in gRPC metadata
+	RequestIDMetadataKey = "x-request-id"
+	// maxRequestIDLength protects logs from oversized IDs sent by clients
+	maxRequestIDLength = 128
+)
+
+type requestIDContextKey struct{}
+
+// NewRequestID generates a new random request ID
+func NewRequestID() string {
+	return uuid.NewString()
+}
+
+// WithRequestID returns a copy of ctx carrying the request ID
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey{}, requestID)
+}
+
+// RequestIDFromContext returns request ID stored in ctx
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	requestID, ok := ctx.Value(requestIDContextKey{}).(string)
+	return requestID, ok && requestID != ""
+}
+
+// validRequestID reports whether request ID received from a client may be reused
+func validRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+
+	for _, r := range requestID {
+		if r < 0x21 || r > 0x7e {
+			return false
+		}
+	}
+
+	return true
+}
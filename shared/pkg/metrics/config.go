@@ -0,0 +1,45 @@
+package metrics
+
+import (
+	"errors"
+	"net"
+	"os"
+)
+
+const (
+	hostEnvName = "METRICS_HOST"
+	portEnvName = "METRICS_PORT"
+)
+
+// Config describes where the standalone /metrics endpoint listens
+type Config interface {
+	Address() string
+}
+
+type config struct {
+	host string
+	port string
+}
+
+// NewEnvConfig reads metrics endpoint address from environment variables
+func NewEnvConfig() (Config, error) {
+	host := os.Getenv(hostEnvName)
+	if len(host) == 0 {
+		return nil, errors.New("metrics host not found")
+	}
+
+	port := os.Getenv(portEnvName)
+	if len(port) == 0 {
+		return nil, errors.New("metrics port not found")
+	}
+
+	return &config{
+		host: host,
+		port: port,
+	}, nil
+}
+
+// Address returns host:port of the metrics endpoint
+func (cfg *config) Address() string {
+	return net.JoinHostPort(cfg.host, cfg.port)
+}
@@ -0,0 +1,97 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// GRPCServerMetrics records rate, errors and duration of handled RPCs
+type GRPCServerMetrics struct {
+	handled  *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+// NewGRPCServerMetrics creates gRPC server metrics and registers them in reg
+func NewGRPCServerMetrics(reg prometheus.Registerer) *GRPCServerMetrics {
+	m := &GRPCServerMetrics{
+		handled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_server_handled_total",
+			Help: "Total number of RPCs completed on the server, regardless of success or failure.",
+		}, []string{"grpc_service", "grpc_method", "grpc_code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "grpc_server_handling_seconds",
+			Help:    "Histogram of response latency of RPCs handled by the server.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"grpc_service", "grpc_method"}),
+	}
+	reg.MustRegister(m.handled, m.duration)
+
+	return m
+}
+
+// UnaryServerInterceptor records metrics of every unary RPC
+func (m *GRPCServerMetrics) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		service, method := splitMethodName(info.FullMethod)
+		m.handled.WithLabelValues(service, method, status.Code(err).String()).Inc()
+		m.duration.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+
+		return resp, err
+	}
+}
+
+// GRPCClientMetrics records rate, errors and duration of outgoing RPCs
+type GRPCClientMetrics struct {
+	handled  *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+// NewGRPCClientMetrics creates gRPC client metrics and registers them in reg
+func NewGRPCClientMetrics(reg prometheus.Registerer) *GRPCClientMetrics {
+	m := &GRPCClientMetrics{
+		handled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_client_handled_total",
+			Help: "Total number of RPCs completed by the client, regardless of success or failure.",
+		}, []string{"grpc_service", "grpc_method", "grpc_code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "grpc_client_handling_seconds",
+			Help:    "Histogram of response latency of RPCs made by the client, including retries.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"grpc_service", "grpc_method"}),
+	}
+	reg.MustRegister(m.handled, m.duration)
+
+	return m
+}
+
+// UnaryClientInterceptor records metrics of every unary RPC
+func (m *GRPCClientMetrics) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, fullMethod string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, fullMethod, req, reply, cc, opts...)
+
+		service, method := splitMethodName(fullMethod)
+		m.handled.WithLabelValues(service, method, status.Code(err).String()).Inc()
+		m.duration.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+
+		return err
+	}
+}
+
+// splitMethodName splits "/package.Service/Method" into service and method names
+func splitMethodName(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.Index(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+
+	return "unknown", "unknown"
+}
@@ -0,0 +1,82 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// unmatchedRoute labels requests that did not match any known route
+const unmatchedRoute = "unmatched"
+
+// RouteFunc returns low cardinality route name of the request, false if there is none
+type RouteFunc func(r *http.Request) (string, bool)
+
+// HTTPMetrics records rate, errors and duration of handled HTTP requests
+type HTTPMetrics struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+// NewHTTPMetrics creates HTTP server metrics and registers them in reg
+func NewHTTPMetrics(reg prometheus.Registerer) *HTTPMetrics {
+	m := &HTTPMetrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "http_requests_total",
+			Help: "Total number of handled HTTP requests.",
+		}, []string{"method", "route", "code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "Histogram of HTTP request handling latency.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "route"}),
+	}
+	reg.MustRegister(m.requests, m.duration)
+
+	return m
+}
+
+// Middleware records metrics of every request.
+// Requests are labelled by route so that path parameters do not blow up cardinality.
+func (m *HTTPMetrics) Middleware(route RouteFunc) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			rw := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+			next.ServeHTTP(rw, r)
+
+			name, ok := route(r)
+			if !ok {
+				name = unmatchedRoute
+			}
+			m.requests.WithLabelValues(r.Method, name, strconv.Itoa(rw.status)).Inc()
+			m.duration.WithLabelValues(r.Method, name).Observe(time.Since(start).Seconds())
+		})
+	}
+}
+
+// Handler returns /metrics handler serving the default registry
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// statusRecorder remembers response status code written by the handler
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+// WriteHeader records status code and passes it to the wrapped writer
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Unwrap exposes the wrapped writer to http.ResponseController
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
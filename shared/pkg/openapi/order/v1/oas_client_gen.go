@@ -39,7 +39,10 @@ type Invoker interface {
 	// Создает новый заказ на основе выбранных
 	// пользователем деталей.
 	// Проверяет наличие всех деталей через InventoryService.
-	// Рассчитывает общую стоимость.
+	// Рассчитывает общую стоимость и применяет скидку по
+	// промокоду.
+	// По запросу проверяет совместимость деталей через
+	// InventoryService.
 	//
 	// POST /orders
 	CreateOrder(ctx context.Context, request *CreateOrderRequest) (CreateOrderRes, error)
@@ -52,9 +55,30 @@ type Invoker interface {
 	// PayOrder invokes payOrder operation.
 	//
 	// Process payment for an existing order.
+	// Prices of the order parts are re-validated against the inventory before the charge.
 	//
 	// POST /orders/{order_uuid}/pay
 	PayOrder(ctx context.Context, request *PayOrderRequest, params PayOrderParams) (PayOrderRes, error)
+	// QuoteOrder invokes quoteOrder operation.
+	//
+	// Рассчитывает стоимость заказа без его создания.
+	// Проверяет наличие деталей через InventoryService и применяет
+	// скидку по промокоду.
+	// Возвращает идентификатор расчета, который можно
+	// передать при создании заказа,
+	// чтобы зафиксировать рассчитанные цены.
+	//
+	// POST /orders/quote
+	QuoteOrder(ctx context.Context, request *QuoteOrderRequest) (QuoteOrderRes, error)
+	// UpdateOrder invokes updateOrder operation.
+	//
+	// Добавляет и удаляет детали заказа, ожидающего оплаты.
+	// Проверяет наличие деталей через InventoryService
+	// и пересчитывает стоимость заказа по текущим ценам с
+	// учетом промокода.
+	//
+	// PATCH /orders/{order_uuid}
+	UpdateOrder(ctx context.Context, request *UpdateOrderRequest, params UpdateOrderParams) (UpdateOrderRes, error)
 }
 
 // Client implements OAS client.
@@ -196,7 +220,10 @@ func (c *Client) sendCancelOrder(ctx context.Context, params CancelOrderParams)
 // Создает новый заказ на основе выбранных
 // пользователем деталей.
 // Проверяет наличие всех деталей через InventoryService.
-// Рассчитывает общую стоимость.
+// Рассчитывает общую стоимость и применяет скидку по
+// промокоду.
+// По запросу проверяет совместимость деталей через
+// InventoryService.
 //
 // POST /orders
 func (c *Client) CreateOrder(ctx context.Context, request *CreateOrderRequest) (CreateOrderRes, error) {
@@ -337,6 +364,36 @@ func (c *Client) sendGetOrderByUuid(ctx context.Context, params GetOrderByUuidPa
 	}
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "expand" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "expand",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.Expand != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.Expand {
+						if err := func() error {
+							return e.EncodeValue(conv.StringToString(string(item)))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -362,6 +419,7 @@ func (c *Client) sendGetOrderByUuid(ctx context.Context, params GetOrderByUuidPa
 // PayOrder invokes payOrder operation.
 //
 // Process payment for an existing order.
+// Prices of the order parts are re-validated against the inventory before the charge.
 //
 // POST /orders/{order_uuid}/pay
 func (c *Client) PayOrder(ctx context.Context, request *PayOrderRequest, params PayOrderParams) (PayOrderRes, error) {
@@ -452,3 +510,179 @@ func (c *Client) sendPayOrder(ctx context.Context, request *PayOrderRequest, par
 
 	return result, nil
 }
+
+// QuoteOrder invokes quoteOrder operation.
+//
+// Рассчитывает стоимость заказа без его создания.
+// Проверяет наличие деталей через InventoryService и применяет
+// скидку по промокоду.
+// Возвращает идентификатор расчета, который можно
+// передать при создании заказа,
+// чтобы зафиксировать рассчитанные цены.
+//
+// POST /orders/quote
+func (c *Client) QuoteOrder(ctx context.Context, request *QuoteOrderRequest) (QuoteOrderRes, error) {
+	res, err := c.sendQuoteOrder(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendQuoteOrder(ctx context.Context, request *QuoteOrderRequest) (res QuoteOrderRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("quoteOrder"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/orders/quote"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, QuoteOrderOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/orders/quote"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeQuoteOrderRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeQuoteOrderResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// UpdateOrder invokes updateOrder operation.
+//
+// Добавляет и удаляет детали заказа, ожидающего оплаты.
+// Проверяет наличие деталей через InventoryService
+// и пересчитывает стоимость заказа по текущим ценам с
+// учетом промокода.
+//
+// PATCH /orders/{order_uuid}
+func (c *Client) UpdateOrder(ctx context.Context, request *UpdateOrderRequest, params UpdateOrderParams) (UpdateOrderRes, error) {
+	res, err := c.sendUpdateOrder(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendUpdateOrder(ctx context.Context, request *UpdateOrderRequest, params UpdateOrderParams) (res UpdateOrderRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("updateOrder"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/orders/{order_uuid}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateOrderOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/orders/"
+	{
+		// Encode "order_uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "order_uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.OrderUUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeUpdateOrderRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeUpdateOrderResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
@@ -2,6 +2,18 @@
 
 package order_v1
 
+// setDefaults set default value of fields.
+func (s *BadGatewayError) setDefaults() {
+	{
+		val := int(502)
+		s.Code = val
+	}
+	{
+		val := string("Bad Gateway")
+		s.Message = val
+	}
+}
+
 // setDefaults set default value of fields.
 func (s *BadRequestError) setDefaults() {
 	{
@@ -26,6 +38,14 @@ func (s *ConflictError) setDefaults() {
 	}
 }
 
+// setDefaults set default value of fields.
+func (s *CreateOrderRequest) setDefaults() {
+	{
+		val := bool(false)
+		s.ValidateCompatibility.SetTo(val)
+	}
+}
+
 // setDefaults set default value of fields.
 func (s *GenericError) setDefaults() {
 	{
@@ -61,3 +81,27 @@ func (s *NotFoundError) setDefaults() {
 		s.Message = val
 	}
 }
+
+// setDefaults set default value of fields.
+func (s *ServiceUnavailableError) setDefaults() {
+	{
+		val := int(503)
+		s.Code = val
+	}
+	{
+		val := string("Service Unavailable")
+		s.Message = val
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *ValidationError) setDefaults() {
+	{
+		val := int(422)
+		s.Code = val
+	}
+	{
+		val := string("Unprocessable Entity")
+		s.Message = val
+	}
+}
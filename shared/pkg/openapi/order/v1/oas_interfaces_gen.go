@@ -16,3 +16,11 @@ type GetOrderByUuidRes interface {
 type PayOrderRes interface {
 	payOrderRes()
 }
+
+type QuoteOrderRes interface {
+	quoteOrderRes()
+}
+
+type UpdateOrderRes interface {
+	updateOrderRes()
+}
@@ -10,4 +10,6 @@ const (
 	CreateOrderOperation    OperationName = "CreateOrder"
 	GetOrderByUuidOperation OperationName = "GetOrderByUuid"
 	PayOrderOperation       OperationName = "PayOrder"
+	QuoteOrderOperation     OperationName = "QuoteOrder"
+	UpdateOrderOperation    OperationName = "UpdateOrder"
 )
@@ -76,6 +76,32 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					break
 				}
 
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'q': // Prefix: "quote"
+					origElem := elem
+					if l := len("quote"); len(elem) >= l && elem[0:l] == "quote" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handleQuoteOrderRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
+					}
+
+					elem = origElem
+				}
 				// Param: "order_uuid"
 				// Match until "/"
 				idx := strings.IndexByte(elem, '/')
@@ -91,8 +117,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						s.handleGetOrderByUuidRequest([1]string{
 							args[0],
 						}, elemIsEscaped, w, r)
+					case "PATCH":
+						s.handleUpdateOrderRequest([1]string{
+							args[0],
+						}, elemIsEscaped, w, r)
 					default:
-						s.notAllowed(w, r, "GET")
+						s.notAllowed(w, r, "GET,PATCH")
 					}
 
 					return
@@ -271,6 +301,36 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					break
 				}
 
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'q': // Prefix: "quote"
+					origElem := elem
+					if l := len("quote"); len(elem) >= l && elem[0:l] == "quote" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "POST":
+							r.name = QuoteOrderOperation
+							r.summary = "Рассчитать стоимость заказа"
+							r.operationID = "quoteOrder"
+							r.pathPattern = "/orders/quote"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				}
 				// Param: "order_uuid"
 				// Match until "/"
 				idx := strings.IndexByte(elem, '/')
@@ -290,6 +350,14 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						r.args = args
 						r.count = 1
 						return r, true
+					case "PATCH":
+						r.name = UpdateOrderOperation
+						r.summary = "Update order parts"
+						r.operationID = "updateOrder"
+						r.pathPattern = "/orders/{order_uuid}"
+						r.args = args
+						r.count = 1
+						return r, true
 					default:
 						return
 					}
@@ -3,10 +3,78 @@
 package order_v1
 
 import (
+	"time"
+
 	"github.com/go-faster/errors"
 	"github.com/google/uuid"
 )
 
+// Скидка, примененная к заказу по промокоду.
+// Присутствует только для заказов, созданных с
+// промокодом.
+// Ref: #
+type AppliedDiscount struct {
+	// Примененный промокод.
+	PromoCode string `json:"promo_code"`
+	// Сумма скидки в денежных единицах.
+	// Представляется в виде десятичного числа с 2 знаками
+	// после запятой.
+	Amount float64 `json:"amount"`
+}
+
+// GetPromoCode returns the value of PromoCode.
+func (s *AppliedDiscount) GetPromoCode() string {
+	return s.PromoCode
+}
+
+// GetAmount returns the value of Amount.
+func (s *AppliedDiscount) GetAmount() float64 {
+	return s.Amount
+}
+
+// SetPromoCode sets the value of PromoCode.
+func (s *AppliedDiscount) SetPromoCode(val string) {
+	s.PromoCode = val
+}
+
+// SetAmount sets the value of Amount.
+func (s *AppliedDiscount) SetAmount(val float64) {
+	s.Amount = val
+}
+
+// Ref: #
+type BadGatewayError struct {
+	// HTTP status code.
+	Code int `json:"code"`
+	// Description of the response.
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *BadGatewayError) GetCode() int {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *BadGatewayError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *BadGatewayError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *BadGatewayError) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*BadGatewayError) createOrderRes() {}
+func (*BadGatewayError) payOrderRes()    {}
+func (*BadGatewayError) quoteOrderRes()  {}
+func (*BadGatewayError) updateOrderRes() {}
+
 // Ref: #
 type BadRequestError struct {
 	// HTTP status code.
@@ -37,6 +105,8 @@ func (s *BadRequestError) SetMessage(val string) {
 
 func (*BadRequestError) createOrderRes() {}
 func (*BadRequestError) payOrderRes()    {}
+func (*BadRequestError) quoteOrderRes()  {}
+func (*BadRequestError) updateOrderRes() {}
 
 // CancelOrderNoContent is response for CancelOrder operation.
 type CancelOrderNoContent struct{}
@@ -49,6 +119,10 @@ type ConflictError struct {
 	Code int `json:"code"`
 	// Description of the response.
 	Message string `json:"message"`
+	// Изменения цен деталей с момента оформления заказа.
+	// Присутствует, только если оплата отклонена из-за
+	// изменения цен.
+	PriceChanges []PriceChange `json:"price_changes"`
 }
 
 // GetCode returns the value of Code.
@@ -61,6 +135,11 @@ func (s *ConflictError) GetMessage() string {
 	return s.Message
 }
 
+// GetPriceChanges returns the value of PriceChanges.
+func (s *ConflictError) GetPriceChanges() []PriceChange {
+	return s.PriceChanges
+}
+
 // SetCode sets the value of Code.
 func (s *ConflictError) SetCode(val int) {
 	s.Code = val
@@ -71,19 +150,45 @@ func (s *ConflictError) SetMessage(val string) {
 	s.Message = val
 }
 
+// SetPriceChanges sets the value of PriceChanges.
+func (s *ConflictError) SetPriceChanges(val []PriceChange) {
+	s.PriceChanges = val
+}
+
 func (*ConflictError) cancelOrderRes() {}
 func (*ConflictError) createOrderRes() {}
 func (*ConflictError) payOrderRes()    {}
+func (*ConflictError) updateOrderRes() {}
 
 // Данные для создания нового заказа.
 // Должен содержать UUID пользователя и хотя бы один UUID
 // детали.
+// Может содержать промокод на скидку и идентификатор
+// расчета стоимости.
+// Может требовать проверки совместимости деталей.
 // Ref: #
 type CreateOrderRequest struct {
 	// Уникальный идентификатор пользователя.
 	UserUUID uuid.UUID `json:"user_uuid"`
 	// Список идентификаторов деталей для заказа.
 	PartUuids []uuid.UUID `json:"part_uuids"`
+	// Промокод на скидку (необязательно).
+	// Регистр символов не учитывается.
+	PromoCode OptString `json:"promo_code"`
+	// Идентификатор расчета стоимости (необязательно).
+	// Заказ создается по ценам из расчета, если расчет не
+	// истек, не использован
+	// другим заказом и совпадает с заказом по пользователю
+	// и деталям.
+	// По одному расчету можно создать только один заказ.
+	// Промокод из расчета применяется, если в запросе не
+	// указан другой.
+	QuoteUUID OptUUID `json:"quote_uuid"`
+	// Проверить, что из деталей заказа можно собрать
+	// корабль (необязательно).
+	// Заказ с несовместимыми деталями отклоняется со
+	// списком нарушенных правил.
+	ValidateCompatibility OptBool `json:"validate_compatibility"`
 }
 
 // GetUserUUID returns the value of UserUUID.
@@ -96,6 +201,21 @@ func (s *CreateOrderRequest) GetPartUuids() []uuid.UUID {
 	return s.PartUuids
 }
 
+// GetPromoCode returns the value of PromoCode.
+func (s *CreateOrderRequest) GetPromoCode() OptString {
+	return s.PromoCode
+}
+
+// GetQuoteUUID returns the value of QuoteUUID.
+func (s *CreateOrderRequest) GetQuoteUUID() OptUUID {
+	return s.QuoteUUID
+}
+
+// GetValidateCompatibility returns the value of ValidateCompatibility.
+func (s *CreateOrderRequest) GetValidateCompatibility() OptBool {
+	return s.ValidateCompatibility
+}
+
 // SetUserUUID sets the value of UserUUID.
 func (s *CreateOrderRequest) SetUserUUID(val uuid.UUID) {
 	s.UserUUID = val
@@ -106,17 +226,39 @@ func (s *CreateOrderRequest) SetPartUuids(val []uuid.UUID) {
 	s.PartUuids = val
 }
 
+// SetPromoCode sets the value of PromoCode.
+func (s *CreateOrderRequest) SetPromoCode(val OptString) {
+	s.PromoCode = val
+}
+
+// SetQuoteUUID sets the value of QuoteUUID.
+func (s *CreateOrderRequest) SetQuoteUUID(val OptUUID) {
+	s.QuoteUUID = val
+}
+
+// SetValidateCompatibility sets the value of ValidateCompatibility.
+func (s *CreateOrderRequest) SetValidateCompatibility(val OptBool) {
+	s.ValidateCompatibility = val
+}
+
 // Ответ при успешном создании заказа.
-// Содержит UUID заказа и рассчитанную итоговую стоимость.
+// Содержит UUID заказа, стоимость до скидки, примененную
+// скидку
+// и рассчитанную итоговую стоимость.
 // Ref: #
 type CreateOrderResponse struct {
 	// Уникальный идентификатор созданного заказа.
 	OrderUUID OptUUID `json:"order_uuid"`
-	// Общая сумма заказа, рассчитанная как сумма
-	// стоимостей всех деталей.
+	// Общая сумма заказа к оплате: сумма стоимостей всех
+	// деталей за вычетом скидки.
 	// Представляется в виде десятичного числа с 2 знаками
 	// после запятой.
 	TotalPrice OptFloat64 `json:"total_price"`
+	// Сумма стоимостей всех деталей до применения скидки.
+	SubtotalPrice OptFloat64         `json:"subtotal_price"`
+	Discount      OptAppliedDiscount `json:"discount"`
+	// Время создания заказа.
+	CreatedAt OptDateTime `json:"created_at"`
 }
 
 // GetOrderUUID returns the value of OrderUUID.
@@ -129,6 +271,21 @@ func (s *CreateOrderResponse) GetTotalPrice() OptFloat64 {
 	return s.TotalPrice
 }
 
+// GetSubtotalPrice returns the value of SubtotalPrice.
+func (s *CreateOrderResponse) GetSubtotalPrice() OptFloat64 {
+	return s.SubtotalPrice
+}
+
+// GetDiscount returns the value of Discount.
+func (s *CreateOrderResponse) GetDiscount() OptAppliedDiscount {
+	return s.Discount
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *CreateOrderResponse) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
 // SetOrderUUID sets the value of OrderUUID.
 func (s *CreateOrderResponse) SetOrderUUID(val OptUUID) {
 	s.OrderUUID = val
@@ -139,8 +296,57 @@ func (s *CreateOrderResponse) SetTotalPrice(val OptFloat64) {
 	s.TotalPrice = val
 }
 
+// SetSubtotalPrice sets the value of SubtotalPrice.
+func (s *CreateOrderResponse) SetSubtotalPrice(val OptFloat64) {
+	s.SubtotalPrice = val
+}
+
+// SetDiscount sets the value of Discount.
+func (s *CreateOrderResponse) SetDiscount(val OptAppliedDiscount) {
+	s.Discount = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *CreateOrderResponse) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
 func (*CreateOrderResponse) createOrderRes() {}
 
+type ExpandItem string
+
+const (
+	ExpandItemParts ExpandItem = "parts"
+)
+
+// AllValues returns all ExpandItem values.
+func (ExpandItem) AllValues() []ExpandItem {
+	return []ExpandItem{
+		ExpandItemParts,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ExpandItem) MarshalText() ([]byte, error) {
+	switch s {
+	case ExpandItemParts:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ExpandItem) UnmarshalText(data []byte) error {
+	switch ExpandItem(data) {
+	case ExpandItemParts:
+		*s = ExpandItemParts
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // Ref: #
 type GenericError struct {
 	// HTTP status code.
@@ -197,6 +403,8 @@ func (s *GenericErrorStatusCode) SetResponse(val GenericError) {
 
 func (*GenericErrorStatusCode) createOrderRes()    {}
 func (*GenericErrorStatusCode) getOrderByUuidRes() {}
+func (*GenericErrorStatusCode) quoteOrderRes()     {}
+func (*GenericErrorStatusCode) updateOrderRes()    {}
 
 // Полная информация о заказе, включая текущий статус,
 // детали оплаты (если заказ оплачен) и список деталей.
@@ -208,7 +416,11 @@ type GetOrderResponse struct {
 	UserUUID uuid.UUID `json:"user_uuid"`
 	// Список деталей в заказе.
 	PartUuids []uuid.UUID `json:"part_uuids"`
-	// Общая сумма заказа в денежных единицах.
+	// Сумма стоимостей всех деталей до применения скидки.
+	SubtotalPrice float64            `json:"subtotal_price"`
+	Discount      OptAppliedDiscount `json:"discount"`
+	// Общая сумма заказа в денежных единицах с учетом
+	// скидки.
 	TotalPrice float64 `json:"total_price"`
 	// Идентификатор платежной транзакции.
 	// Присутствует только для оплаченных заказов.
@@ -216,8 +428,29 @@ type GetOrderResponse struct {
 	// Использованный способ оплаты.
 	// Присутствует только для оплаченных заказов.
 	PaymentMethod OptPaymentMethod `json:"payment_method"`
+	// Цены, по которым списана сумма заказа.
+	// Присутствует только для оплаченных заказов.
+	PriceBasis OptPriceBasis `json:"price_basis"`
 	// Текущий статус заказа.
 	Status OrderStatus `json:"status"`
+	// Время создания заказа.
+	CreatedAt time.Time `json:"created_at"`
+	// Время последнего изменения заказа.
+	UpdatedAt time.Time `json:"updated_at"`
+	// Время оплаты заказа.
+	// Присутствует только для оплаченных заказов.
+	PaidAt OptDateTime `json:"paid_at"`
+	// Время отмены заказа.
+	// Присутствует только для отмененных заказов.
+	CancelledAt OptDateTime `json:"cancelled_at"`
+	// Сведения о деталях в порядке part_uuids.
+	// Присутствует только при запросе с expand=parts.
+	Parts []OrderPart `json:"parts"`
+	// InventoryService недоступен, поэтому сведения о деталях
+	// неполные:
+	// присутствуют только цены на момент заказа.
+	// Присутствует только при запросе с expand=parts.
+	PartsDegraded OptBool `json:"parts_degraded"`
 }
 
 // GetOrderUUID returns the value of OrderUUID.
@@ -235,6 +468,16 @@ func (s *GetOrderResponse) GetPartUuids() []uuid.UUID {
 	return s.PartUuids
 }
 
+// GetSubtotalPrice returns the value of SubtotalPrice.
+func (s *GetOrderResponse) GetSubtotalPrice() float64 {
+	return s.SubtotalPrice
+}
+
+// GetDiscount returns the value of Discount.
+func (s *GetOrderResponse) GetDiscount() OptAppliedDiscount {
+	return s.Discount
+}
+
 // GetTotalPrice returns the value of TotalPrice.
 func (s *GetOrderResponse) GetTotalPrice() float64 {
 	return s.TotalPrice
@@ -250,11 +493,46 @@ func (s *GetOrderResponse) GetPaymentMethod() OptPaymentMethod {
 	return s.PaymentMethod
 }
 
+// GetPriceBasis returns the value of PriceBasis.
+func (s *GetOrderResponse) GetPriceBasis() OptPriceBasis {
+	return s.PriceBasis
+}
+
 // GetStatus returns the value of Status.
 func (s *GetOrderResponse) GetStatus() OrderStatus {
 	return s.Status
 }
 
+// GetCreatedAt returns the value of CreatedAt.
+func (s *GetOrderResponse) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *GetOrderResponse) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// GetPaidAt returns the value of PaidAt.
+func (s *GetOrderResponse) GetPaidAt() OptDateTime {
+	return s.PaidAt
+}
+
+// GetCancelledAt returns the value of CancelledAt.
+func (s *GetOrderResponse) GetCancelledAt() OptDateTime {
+	return s.CancelledAt
+}
+
+// GetParts returns the value of Parts.
+func (s *GetOrderResponse) GetParts() []OrderPart {
+	return s.Parts
+}
+
+// GetPartsDegraded returns the value of PartsDegraded.
+func (s *GetOrderResponse) GetPartsDegraded() OptBool {
+	return s.PartsDegraded
+}
+
 // SetOrderUUID sets the value of OrderUUID.
 func (s *GetOrderResponse) SetOrderUUID(val uuid.UUID) {
 	s.OrderUUID = val
@@ -270,6 +548,16 @@ func (s *GetOrderResponse) SetPartUuids(val []uuid.UUID) {
 	s.PartUuids = val
 }
 
+// SetSubtotalPrice sets the value of SubtotalPrice.
+func (s *GetOrderResponse) SetSubtotalPrice(val float64) {
+	s.SubtotalPrice = val
+}
+
+// SetDiscount sets the value of Discount.
+func (s *GetOrderResponse) SetDiscount(val OptAppliedDiscount) {
+	s.Discount = val
+}
+
 // SetTotalPrice sets the value of TotalPrice.
 func (s *GetOrderResponse) SetTotalPrice(val float64) {
 	s.TotalPrice = val
@@ -285,12 +573,48 @@ func (s *GetOrderResponse) SetPaymentMethod(val OptPaymentMethod) {
 	s.PaymentMethod = val
 }
 
+// SetPriceBasis sets the value of PriceBasis.
+func (s *GetOrderResponse) SetPriceBasis(val OptPriceBasis) {
+	s.PriceBasis = val
+}
+
 // SetStatus sets the value of Status.
 func (s *GetOrderResponse) SetStatus(val OrderStatus) {
 	s.Status = val
 }
 
+// SetCreatedAt sets the value of CreatedAt.
+func (s *GetOrderResponse) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *GetOrderResponse) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+// SetPaidAt sets the value of PaidAt.
+func (s *GetOrderResponse) SetPaidAt(val OptDateTime) {
+	s.PaidAt = val
+}
+
+// SetCancelledAt sets the value of CancelledAt.
+func (s *GetOrderResponse) SetCancelledAt(val OptDateTime) {
+	s.CancelledAt = val
+}
+
+// SetParts sets the value of Parts.
+func (s *GetOrderResponse) SetParts(val []OrderPart) {
+	s.Parts = val
+}
+
+// SetPartsDegraded sets the value of PartsDegraded.
+func (s *GetOrderResponse) SetPartsDegraded(val OptBool) {
+	s.PartsDegraded = val
+}
+
 func (*GetOrderResponse) getOrderByUuidRes() {}
+func (*GetOrderResponse) updateOrderRes()    {}
 
 // Ref: #
 type InternalServerError struct {
@@ -322,6 +646,7 @@ func (s *InternalServerError) SetMessage(val string) {
 
 func (*InternalServerError) createOrderRes()    {}
 func (*InternalServerError) getOrderByUuidRes() {}
+func (*InternalServerError) quoteOrderRes()     {}
 
 // InternalServerErrorStatusCode wraps InternalServerError with StatusCode.
 type InternalServerErrorStatusCode struct {
@@ -383,6 +708,145 @@ func (s *NotFoundError) SetMessage(val string) {
 func (*NotFoundError) cancelOrderRes()    {}
 func (*NotFoundError) getOrderByUuidRes() {}
 func (*NotFoundError) payOrderRes()       {}
+func (*NotFoundError) updateOrderRes()    {}
+
+// NewOptAppliedDiscount returns new OptAppliedDiscount with value set to v.
+func NewOptAppliedDiscount(v AppliedDiscount) OptAppliedDiscount {
+	return OptAppliedDiscount{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAppliedDiscount is optional AppliedDiscount.
+type OptAppliedDiscount struct {
+	Value AppliedDiscount
+	Set   bool
+}
+
+// IsSet returns true if OptAppliedDiscount was set.
+func (o OptAppliedDiscount) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAppliedDiscount) Reset() {
+	var v AppliedDiscount
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAppliedDiscount) SetTo(v AppliedDiscount) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAppliedDiscount) Get() (v AppliedDiscount, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAppliedDiscount) Or(d AppliedDiscount) AppliedDiscount {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
 
 // NewOptFloat64 returns new OptFloat64 with value set to v.
 func NewOptFloat64(v float64) OptFloat64 {
@@ -585,38 +1049,38 @@ func (o OptPaymentMethod) Or(d PaymentMethod) PaymentMethod {
 	return d
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
+// NewOptPriceBasis returns new OptPriceBasis with value set to v.
+func NewOptPriceBasis(v PriceBasis) OptPriceBasis {
+	return OptPriceBasis{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
+// OptPriceBasis is optional PriceBasis.
+type OptPriceBasis struct {
+	Value PriceBasis
 	Set   bool
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// IsSet returns true if OptPriceBasis was set.
+func (o OptPriceBasis) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
+func (o *OptPriceBasis) Reset() {
+	var v PriceBasis
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
+func (o *OptPriceBasis) SetTo(v PriceBasis) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
+func (o OptPriceBasis) Get() (v PriceBasis, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -624,33 +1088,79 @@ func (o OptString) Get() (v string, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
+func (o OptPriceBasis) Or(d PriceBasis) PriceBasis {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptUUID returns new OptUUID with value set to v.
-func NewOptUUID(v uuid.UUID) OptUUID {
-	return OptUUID{
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptUUID is optional uuid.UUID.
-type OptUUID struct {
-	Value uuid.UUID
+// OptString is optional string.
+type OptString struct {
+	Value string
 	Set   bool
 }
 
-// IsSet returns true if OptUUID was set.
-func (o OptUUID) IsSet() bool { return o.Set }
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptUUID) Reset() {
-	var v uuid.UUID
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUUID returns new OptUUID with value set to v.
+func NewOptUUID(v uuid.UUID) OptUUID {
+	return OptUUID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUUID is optional uuid.UUID.
+type OptUUID struct {
+	Value uuid.UUID
+	Set   bool
+}
+
+// IsSet returns true if OptUUID was set.
+func (o OptUUID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUUID) Reset() {
+	var v uuid.UUID
 	o.Value = v
 	o.Set = false
 }
@@ -677,6 +1187,76 @@ func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
 	return d
 }
 
+// Сведения о детали заказа.
+// Название, категория и наличие отсутствуют, если
+// InventoryService недоступен
+// или деталь больше не найдена.
+// Ref: #
+type OrderPart struct {
+	// Идентификатор детали.
+	PartUUID uuid.UUID `json:"part_uuid"`
+	// Название детали.
+	Name OptString `json:"name"`
+	// Категория детали (ENGINE, FUEL, PORTHOLE, WING).
+	Category OptString `json:"category"`
+	// Цена детали на момент оформления или последнего
+	// изменения заказа.
+	UnitPrice OptFloat64 `json:"unit_price"`
+	// Деталь есть на складе в количестве, достаточном для
+	// заказа.
+	Available OptBool `json:"available"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *OrderPart) GetPartUUID() uuid.UUID {
+	return s.PartUUID
+}
+
+// GetName returns the value of Name.
+func (s *OrderPart) GetName() OptString {
+	return s.Name
+}
+
+// GetCategory returns the value of Category.
+func (s *OrderPart) GetCategory() OptString {
+	return s.Category
+}
+
+// GetUnitPrice returns the value of UnitPrice.
+func (s *OrderPart) GetUnitPrice() OptFloat64 {
+	return s.UnitPrice
+}
+
+// GetAvailable returns the value of Available.
+func (s *OrderPart) GetAvailable() OptBool {
+	return s.Available
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *OrderPart) SetPartUUID(val uuid.UUID) {
+	s.PartUUID = val
+}
+
+// SetName sets the value of Name.
+func (s *OrderPart) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetCategory sets the value of Category.
+func (s *OrderPart) SetCategory(val OptString) {
+	s.Category = val
+}
+
+// SetUnitPrice sets the value of UnitPrice.
+func (s *OrderPart) SetUnitPrice(val OptFloat64) {
+	s.UnitPrice = val
+}
+
+// SetAvailable sets the value of Available.
+func (s *OrderPart) SetAvailable(val OptBool) {
+	s.Available = val
+}
+
 // Возможные статусы заказа:
 // - PENDING_PAYMENT - Заказ создан, но не оплачен
 // - PAID - Заказ успешно оплачен
@@ -749,12 +1329,18 @@ func (s *PayOrderRequest) SetPaymentMethod(val PaymentMethod) {
 }
 
 // Ответ при успешной оплате заказа.
-// Содержит идентификатор транзакции.
+// Содержит идентификатор транзакции и списанную сумму.
 // Ref: #
 type PayOrderResponse struct {
 	// Уникальный идентификатор платежной транзакции.
 	// Генерируется платежной системой.
 	TransactionUUID OptUUID `json:"transaction_uuid"`
+	// Списанная сумма заказа.
+	// Может отличаться от суммы при оформлении, если заказ
+	// пересчитан по текущим ценам.
+	TotalPrice OptFloat64 `json:"total_price"`
+	// Цены, по которым списана сумма заказа.
+	PriceBasis OptPriceBasis `json:"price_basis"`
 }
 
 // GetTransactionUUID returns the value of TransactionUUID.
@@ -762,11 +1348,31 @@ func (s *PayOrderResponse) GetTransactionUUID() OptUUID {
 	return s.TransactionUUID
 }
 
+// GetTotalPrice returns the value of TotalPrice.
+func (s *PayOrderResponse) GetTotalPrice() OptFloat64 {
+	return s.TotalPrice
+}
+
+// GetPriceBasis returns the value of PriceBasis.
+func (s *PayOrderResponse) GetPriceBasis() OptPriceBasis {
+	return s.PriceBasis
+}
+
 // SetTransactionUUID sets the value of TransactionUUID.
 func (s *PayOrderResponse) SetTransactionUUID(val OptUUID) {
 	s.TransactionUUID = val
 }
 
+// SetTotalPrice sets the value of TotalPrice.
+func (s *PayOrderResponse) SetTotalPrice(val OptFloat64) {
+	s.TotalPrice = val
+}
+
+// SetPriceBasis sets the value of PriceBasis.
+func (s *PayOrderResponse) SetPriceBasis(val OptPriceBasis) {
+	s.PriceBasis = val
+}
+
 func (*PayOrderResponse) payOrderRes() {}
 
 // Доступные методы оплаты:
@@ -837,3 +1443,526 @@ func (s *PaymentMethod) UnmarshalText(data []byte) error {
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
+
+// Цены, по которым списана сумма заказа:
+// - ORDER_PRICES - Цены на момент оформления или последнего
+// изменения заказа
+// - CURRENT_PRICES - Текущие цены склада, проверенные при оплате.
+// Ref: #
+type PriceBasis string
+
+const (
+	PriceBasisORDERPRICES   PriceBasis = "ORDER_PRICES"
+	PriceBasisCURRENTPRICES PriceBasis = "CURRENT_PRICES"
+)
+
+// AllValues returns all PriceBasis values.
+func (PriceBasis) AllValues() []PriceBasis {
+	return []PriceBasis{
+		PriceBasisORDERPRICES,
+		PriceBasisCURRENTPRICES,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PriceBasis) MarshalText() ([]byte, error) {
+	switch s {
+	case PriceBasisORDERPRICES:
+		return []byte(s), nil
+	case PriceBasisCURRENTPRICES:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PriceBasis) UnmarshalText(data []byte) error {
+	switch PriceBasis(data) {
+	case PriceBasisORDERPRICES:
+		*s = PriceBasisORDERPRICES
+		return nil
+	case PriceBasisCURRENTPRICES:
+		*s = PriceBasisCURRENTPRICES
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Изменение цены детали с момента оформления заказа.
+// Ref: #
+type PriceChange struct {
+	// Идентификатор детали.
+	PartUUID uuid.UUID `json:"part_uuid"`
+	// Цена детали в заказе.
+	OrderPrice float64 `json:"order_price"`
+	// Текущая цена детали на складе.
+	// Отсутствует, если деталь удалена из каталога.
+	CurrentPrice OptFloat64 `json:"current_price"`
+	// Деталь удалена из каталога.
+	Removed bool `json:"removed"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *PriceChange) GetPartUUID() uuid.UUID {
+	return s.PartUUID
+}
+
+// GetOrderPrice returns the value of OrderPrice.
+func (s *PriceChange) GetOrderPrice() float64 {
+	return s.OrderPrice
+}
+
+// GetCurrentPrice returns the value of CurrentPrice.
+func (s *PriceChange) GetCurrentPrice() OptFloat64 {
+	return s.CurrentPrice
+}
+
+// GetRemoved returns the value of Removed.
+func (s *PriceChange) GetRemoved() bool {
+	return s.Removed
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *PriceChange) SetPartUUID(val uuid.UUID) {
+	s.PartUUID = val
+}
+
+// SetOrderPrice sets the value of OrderPrice.
+func (s *PriceChange) SetOrderPrice(val float64) {
+	s.OrderPrice = val
+}
+
+// SetCurrentPrice sets the value of CurrentPrice.
+func (s *PriceChange) SetCurrentPrice(val OptFloat64) {
+	s.CurrentPrice = val
+}
+
+// SetRemoved sets the value of Removed.
+func (s *PriceChange) SetRemoved(val bool) {
+	s.Removed = val
+}
+
+// Позиция расчета стоимости заказа.
+// Ref: #
+type QuoteItem struct {
+	// Идентификатор детали.
+	PartUUID uuid.UUID `json:"part_uuid"`
+	// Название детали.
+	Name string `json:"name"`
+	// Категория детали (ENGINE, FUEL, PORTHOLE, WING).
+	Category string `json:"category"`
+	// Цена детали, зафиксированная в расчете.
+	Price float64 `json:"price"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *QuoteItem) GetPartUUID() uuid.UUID {
+	return s.PartUUID
+}
+
+// GetName returns the value of Name.
+func (s *QuoteItem) GetName() string {
+	return s.Name
+}
+
+// GetCategory returns the value of Category.
+func (s *QuoteItem) GetCategory() string {
+	return s.Category
+}
+
+// GetPrice returns the value of Price.
+func (s *QuoteItem) GetPrice() float64 {
+	return s.Price
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *QuoteItem) SetPartUUID(val uuid.UUID) {
+	s.PartUUID = val
+}
+
+// SetName sets the value of Name.
+func (s *QuoteItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetCategory sets the value of Category.
+func (s *QuoteItem) SetCategory(val string) {
+	s.Category = val
+}
+
+// SetPrice sets the value of Price.
+func (s *QuoteItem) SetPrice(val float64) {
+	s.Price = val
+}
+
+// Данные для предварительного расчета стоимости
+// заказа.
+// Совпадают с данными для создания заказа.
+// Ref: #
+type QuoteOrderRequest struct {
+	// Уникальный идентификатор пользователя.
+	UserUUID uuid.UUID `json:"user_uuid"`
+	// Список идентификаторов деталей для заказа.
+	PartUuids []uuid.UUID `json:"part_uuids"`
+	// Промокод на скидку (необязательно).
+	// Лимиты использования промокода проверяются только
+	// при создании заказа.
+	PromoCode OptString `json:"promo_code"`
+}
+
+// GetUserUUID returns the value of UserUUID.
+func (s *QuoteOrderRequest) GetUserUUID() uuid.UUID {
+	return s.UserUUID
+}
+
+// GetPartUuids returns the value of PartUuids.
+func (s *QuoteOrderRequest) GetPartUuids() []uuid.UUID {
+	return s.PartUuids
+}
+
+// GetPromoCode returns the value of PromoCode.
+func (s *QuoteOrderRequest) GetPromoCode() OptString {
+	return s.PromoCode
+}
+
+// SetUserUUID sets the value of UserUUID.
+func (s *QuoteOrderRequest) SetUserUUID(val uuid.UUID) {
+	s.UserUUID = val
+}
+
+// SetPartUuids sets the value of PartUuids.
+func (s *QuoteOrderRequest) SetPartUuids(val []uuid.UUID) {
+	s.PartUuids = val
+}
+
+// SetPromoCode sets the value of PromoCode.
+func (s *QuoteOrderRequest) SetPromoCode(val OptString) {
+	s.PromoCode = val
+}
+
+// Предварительный расчет стоимости заказа.
+// Содержит цены доступных деталей, список недоступных
+// деталей
+// и идентификатор расчета, по которому можно создать
+// заказ по зафиксированным ценам.
+// Ref: #
+type QuoteOrderResponse struct {
+	// Уникальный идентификатор расчета.
+	QuoteUUID uuid.UUID `json:"quote_uuid"`
+	// Момент, до которого расчет можно использовать для
+	// создания заказа.
+	ExpiresAt time.Time `json:"expires_at"`
+	// Цены доступных деталей в порядке их указания в
+	// запросе.
+	Items []QuoteItem `json:"items"`
+	// Детали, которые не найдены или отсутствуют на складе
+	// в нужном количестве.
+	// Заказ по расчету с недоступными деталями создать
+	// нельзя.
+	UnavailablePartUuids []uuid.UUID `json:"unavailable_part_uuids"`
+	// Сумма стоимостей доступных деталей до применения
+	// скидки.
+	SubtotalPrice float64            `json:"subtotal_price"`
+	Discount      OptAppliedDiscount `json:"discount"`
+	// Итоговая стоимость доступных деталей с учетом скидки.
+	TotalPrice float64 `json:"total_price"`
+}
+
+// GetQuoteUUID returns the value of QuoteUUID.
+func (s *QuoteOrderResponse) GetQuoteUUID() uuid.UUID {
+	return s.QuoteUUID
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *QuoteOrderResponse) GetExpiresAt() time.Time {
+	return s.ExpiresAt
+}
+
+// GetItems returns the value of Items.
+func (s *QuoteOrderResponse) GetItems() []QuoteItem {
+	return s.Items
+}
+
+// GetUnavailablePartUuids returns the value of UnavailablePartUuids.
+func (s *QuoteOrderResponse) GetUnavailablePartUuids() []uuid.UUID {
+	return s.UnavailablePartUuids
+}
+
+// GetSubtotalPrice returns the value of SubtotalPrice.
+func (s *QuoteOrderResponse) GetSubtotalPrice() float64 {
+	return s.SubtotalPrice
+}
+
+// GetDiscount returns the value of Discount.
+func (s *QuoteOrderResponse) GetDiscount() OptAppliedDiscount {
+	return s.Discount
+}
+
+// GetTotalPrice returns the value of TotalPrice.
+func (s *QuoteOrderResponse) GetTotalPrice() float64 {
+	return s.TotalPrice
+}
+
+// SetQuoteUUID sets the value of QuoteUUID.
+func (s *QuoteOrderResponse) SetQuoteUUID(val uuid.UUID) {
+	s.QuoteUUID = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *QuoteOrderResponse) SetExpiresAt(val time.Time) {
+	s.ExpiresAt = val
+}
+
+// SetItems sets the value of Items.
+func (s *QuoteOrderResponse) SetItems(val []QuoteItem) {
+	s.Items = val
+}
+
+// SetUnavailablePartUuids sets the value of UnavailablePartUuids.
+func (s *QuoteOrderResponse) SetUnavailablePartUuids(val []uuid.UUID) {
+	s.UnavailablePartUuids = val
+}
+
+// SetSubtotalPrice sets the value of SubtotalPrice.
+func (s *QuoteOrderResponse) SetSubtotalPrice(val float64) {
+	s.SubtotalPrice = val
+}
+
+// SetDiscount sets the value of Discount.
+func (s *QuoteOrderResponse) SetDiscount(val OptAppliedDiscount) {
+	s.Discount = val
+}
+
+// SetTotalPrice sets the value of TotalPrice.
+func (s *QuoteOrderResponse) SetTotalPrice(val float64) {
+	s.TotalPrice = val
+}
+
+func (*QuoteOrderResponse) quoteOrderRes() {}
+
+// Вид правила совместимости:
+// - REQUIRES - Детали требуют наличия других деталей
+// - EXCLUDES - Детали нельзя сочетать с другими деталями
+// - AT_LEAST - В заказе должно быть не меньше указанного
+// количества деталей.
+// Ref: #
+type RuleKind string
+
+const (
+	RuleKindREQUIRES RuleKind = "REQUIRES"
+	RuleKindEXCLUDES RuleKind = "EXCLUDES"
+	RuleKindATLEAST  RuleKind = "AT_LEAST"
+)
+
+// AllValues returns all RuleKind values.
+func (RuleKind) AllValues() []RuleKind {
+	return []RuleKind{
+		RuleKindREQUIRES,
+		RuleKindEXCLUDES,
+		RuleKindATLEAST,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RuleKind) MarshalText() ([]byte, error) {
+	switch s {
+	case RuleKindREQUIRES:
+		return []byte(s), nil
+	case RuleKindEXCLUDES:
+		return []byte(s), nil
+	case RuleKindATLEAST:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RuleKind) UnmarshalText(data []byte) error {
+	switch RuleKind(data) {
+	case RuleKindREQUIRES:
+		*s = RuleKindREQUIRES
+		return nil
+	case RuleKindEXCLUDES:
+		*s = RuleKindEXCLUDES
+		return nil
+	case RuleKindATLEAST:
+		*s = RuleKindATLEAST
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Нарушение правила совместимости деталей заказа.
+// Ref: #
+type RuleViolation struct {
+	// Идентификатор правила.
+	RuleID string   `json:"rule_id"`
+	Kind   RuleKind `json:"kind"`
+	// Пояснение правила.
+	Description OptString `json:"description"`
+	// Описание нарушения.
+	Message string `json:"message"`
+	// Детали заказа, нарушающие правило.
+	PartUuids []uuid.UUID `json:"part_uuids"`
+}
+
+// GetRuleID returns the value of RuleID.
+func (s *RuleViolation) GetRuleID() string {
+	return s.RuleID
+}
+
+// GetKind returns the value of Kind.
+func (s *RuleViolation) GetKind() RuleKind {
+	return s.Kind
+}
+
+// GetDescription returns the value of Description.
+func (s *RuleViolation) GetDescription() OptString {
+	return s.Description
+}
+
+// GetMessage returns the value of Message.
+func (s *RuleViolation) GetMessage() string {
+	return s.Message
+}
+
+// GetPartUuids returns the value of PartUuids.
+func (s *RuleViolation) GetPartUuids() []uuid.UUID {
+	return s.PartUuids
+}
+
+// SetRuleID sets the value of RuleID.
+func (s *RuleViolation) SetRuleID(val string) {
+	s.RuleID = val
+}
+
+// SetKind sets the value of Kind.
+func (s *RuleViolation) SetKind(val RuleKind) {
+	s.Kind = val
+}
+
+// SetDescription sets the value of Description.
+func (s *RuleViolation) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetMessage sets the value of Message.
+func (s *RuleViolation) SetMessage(val string) {
+	s.Message = val
+}
+
+// SetPartUuids sets the value of PartUuids.
+func (s *RuleViolation) SetPartUuids(val []uuid.UUID) {
+	s.PartUuids = val
+}
+
+// Ref: #
+type ServiceUnavailableError struct {
+	// HTTP status code.
+	Code int `json:"code"`
+	// Description of the response.
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *ServiceUnavailableError) GetCode() int {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *ServiceUnavailableError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *ServiceUnavailableError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ServiceUnavailableError) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*ServiceUnavailableError) createOrderRes() {}
+func (*ServiceUnavailableError) payOrderRes()    {}
+func (*ServiceUnavailableError) quoteOrderRes()  {}
+func (*ServiceUnavailableError) updateOrderRes() {}
+
+// Изменение списка деталей заказа, ожидающего оплаты.
+// Должен содержать хотя бы одну добавляемую или
+// удаляемую деталь.
+// Ref: #
+type UpdateOrderRequest struct {
+	// Детали, добавляемые в заказ.
+	AddPartUuids []uuid.UUID `json:"add_part_uuids"`
+	// Детали, удаляемые из заказа.
+	// Каждый UUID удаляет одну позицию с этой деталью.
+	RemovePartUuids []uuid.UUID `json:"remove_part_uuids"`
+}
+
+// GetAddPartUuids returns the value of AddPartUuids.
+func (s *UpdateOrderRequest) GetAddPartUuids() []uuid.UUID {
+	return s.AddPartUuids
+}
+
+// GetRemovePartUuids returns the value of RemovePartUuids.
+func (s *UpdateOrderRequest) GetRemovePartUuids() []uuid.UUID {
+	return s.RemovePartUuids
+}
+
+// SetAddPartUuids sets the value of AddPartUuids.
+func (s *UpdateOrderRequest) SetAddPartUuids(val []uuid.UUID) {
+	s.AddPartUuids = val
+}
+
+// SetRemovePartUuids sets the value of RemovePartUuids.
+func (s *UpdateOrderRequest) SetRemovePartUuids(val []uuid.UUID) {
+	s.RemovePartUuids = val
+}
+
+// Ref: #
+type ValidationError struct {
+	// HTTP status code.
+	Code int `json:"code"`
+	// Description of the response.
+	Message string `json:"message"`
+	// Нарушенные правила совместимости деталей.
+	Violations []RuleViolation `json:"violations"`
+}
+
+// GetCode returns the value of Code.
+func (s *ValidationError) GetCode() int {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *ValidationError) GetMessage() string {
+	return s.Message
+}
+
+// GetViolations returns the value of Violations.
+func (s *ValidationError) GetViolations() []RuleViolation {
+	return s.Violations
+}
+
+// SetCode sets the value of Code.
+func (s *ValidationError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ValidationError) SetMessage(val string) {
+	s.Message = val
+}
+
+// SetViolations sets the value of Violations.
+func (s *ValidationError) SetViolations(val []RuleViolation) {
+	s.Violations = val
+}
+
+func (*ValidationError) createOrderRes() {}
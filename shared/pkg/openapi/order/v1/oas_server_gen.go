@@ -19,7 +19,10 @@ type Handler interface {
 	// Создает новый заказ на основе выбранных
 	// пользователем деталей.
 	// Проверяет наличие всех деталей через InventoryService.
-	// Рассчитывает общую стоимость.
+	// Рассчитывает общую стоимость и применяет скидку по
+	// промокоду.
+	// По запросу проверяет совместимость деталей через
+	// InventoryService.
 	//
 	// POST /orders
 	CreateOrder(ctx context.Context, req *CreateOrderRequest) (CreateOrderRes, error)
@@ -32,9 +35,30 @@ type Handler interface {
 	// PayOrder implements payOrder operation.
 	//
 	// Process payment for an existing order.
+	// Prices of the order parts are re-validated against the inventory before the charge.
 	//
 	// POST /orders/{order_uuid}/pay
 	PayOrder(ctx context.Context, req *PayOrderRequest, params PayOrderParams) (PayOrderRes, error)
+	// QuoteOrder implements quoteOrder operation.
+	//
+	// Рассчитывает стоимость заказа без его создания.
+	// Проверяет наличие деталей через InventoryService и применяет
+	// скидку по промокоду.
+	// Возвращает идентификатор расчета, который можно
+	// передать при создании заказа,
+	// чтобы зафиксировать рассчитанные цены.
+	//
+	// POST /orders/quote
+	QuoteOrder(ctx context.Context, req *QuoteOrderRequest) (QuoteOrderRes, error)
+	// UpdateOrder implements updateOrder operation.
+	//
+	// Добавляет и удаляет детали заказа, ожидающего оплаты.
+	// Проверяет наличие деталей через InventoryService
+	// и пересчитывает стоимость заказа по текущим ценам с
+	// учетом промокода.
+	//
+	// PATCH /orders/{order_uuid}
+	UpdateOrder(ctx context.Context, req *UpdateOrderRequest, params UpdateOrderParams) (UpdateOrderRes, error)
 }
 
 // Server implements http server based on OpenAPI v3 specification and
@@ -27,7 +27,10 @@ func (UnimplementedHandler) CancelOrder(ctx context.Context, params CancelOrderP
 // Создает новый заказ на основе выбранных
 // пользователем деталей.
 // Проверяет наличие всех деталей через InventoryService.
-// Рассчитывает общую стоимость.
+// Рассчитывает общую стоимость и применяет скидку по
+// промокоду.
+// По запросу проверяет совместимость деталей через
+// InventoryService.
 //
 // POST /orders
 func (UnimplementedHandler) CreateOrder(ctx context.Context, req *CreateOrderRequest) (r CreateOrderRes, _ error) {
@@ -46,8 +49,35 @@ func (UnimplementedHandler) GetOrderByUuid(ctx context.Context, params GetOrderB
 // PayOrder implements payOrder operation.
 //
 // Process payment for an existing order.
+// Prices of the order parts are re-validated against the inventory before the charge.
 //
 // POST /orders/{order_uuid}/pay
 func (UnimplementedHandler) PayOrder(ctx context.Context, req *PayOrderRequest, params PayOrderParams) (r PayOrderRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
+
+// QuoteOrder implements quoteOrder operation.
+//
+// Рассчитывает стоимость заказа без его создания.
+// Проверяет наличие деталей через InventoryService и применяет
+// скидку по промокоду.
+// Возвращает идентификатор расчета, который можно
+// передать при создании заказа,
+// чтобы зафиксировать рассчитанные цены.
+//
+// POST /orders/quote
+func (UnimplementedHandler) QuoteOrder(ctx context.Context, req *QuoteOrderRequest) (r QuoteOrderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateOrder implements updateOrder operation.
+//
+// Добавляет и удаляет детали заказа, ожидающего оплаты.
+// Проверяет наличие деталей через InventoryService
+// и пересчитывает стоимость заказа по текущим ценам с
+// учетом промокода.
+//
+// PATCH /orders/{order_uuid}
+func (UnimplementedHandler) UpdateOrder(ctx context.Context, req *UpdateOrderRequest, params UpdateOrderParams) (r UpdateOrderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
@@ -22,6 +22,271 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Тип изменения детали
+type PartEventType int32
+
+const (
+	PartEventType_PART_EVENT_TYPE_UNSPECIFIED PartEventType = 0 // Неизвестное изменение
+	PartEventType_PART_EVENT_TYPE_CREATED     PartEventType = 1 // Деталь создана
+	PartEventType_PART_EVENT_TYPE_UPDATED     PartEventType = 2 // Деталь изменена
+	PartEventType_PART_EVENT_TYPE_DELETED     PartEventType = 3 // Деталь удалена
+)
+
+// Enum value maps for PartEventType.
+var (
+	PartEventType_name = map[int32]string{
+		0: "PART_EVENT_TYPE_UNSPECIFIED",
+		1: "PART_EVENT_TYPE_CREATED",
+		2: "PART_EVENT_TYPE_UPDATED",
+		3: "PART_EVENT_TYPE_DELETED",
+	}
+	PartEventType_value = map[string]int32{
+		"PART_EVENT_TYPE_UNSPECIFIED": 0,
+		"PART_EVENT_TYPE_CREATED":     1,
+		"PART_EVENT_TYPE_UPDATED":     2,
+		"PART_EVENT_TYPE_DELETED":     3,
+	}
+)
+
+func (x PartEventType) Enum() *PartEventType {
+	p := new(PartEventType)
+	*p = x
+	return p
+}
+
+func (x PartEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PartEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[0].Descriptor()
+}
+
+func (PartEventType) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[0]
+}
+
+func (x PartEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PartEventType.Descriptor instead.
+func (PartEventType) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+// Причина изменения количества детали
+type StockReason int32
+
+const (
+	StockReason_STOCK_REASON_UNSPECIFIED StockReason = 0 // Неизвестная причина
+	StockReason_STOCK_REASON_RECEIPT     StockReason = 1 // Поступление от поставщика
+	StockReason_STOCK_REASON_SALE        StockReason = 2 // Продажа
+	StockReason_STOCK_REASON_DAMAGE      StockReason = 3 // Списание из-за повреждения
+	StockReason_STOCK_REASON_CORRECTION  StockReason = 4 // Корректировка по результатам инвентаризации
+)
+
+// Enum value maps for StockReason.
+var (
+	StockReason_name = map[int32]string{
+		0: "STOCK_REASON_UNSPECIFIED",
+		1: "STOCK_REASON_RECEIPT",
+		2: "STOCK_REASON_SALE",
+		3: "STOCK_REASON_DAMAGE",
+		4: "STOCK_REASON_CORRECTION",
+	}
+	StockReason_value = map[string]int32{
+		"STOCK_REASON_UNSPECIFIED": 0,
+		"STOCK_REASON_RECEIPT":     1,
+		"STOCK_REASON_SALE":        2,
+		"STOCK_REASON_DAMAGE":      3,
+		"STOCK_REASON_CORRECTION":  4,
+	}
+)
+
+func (x StockReason) Enum() *StockReason {
+	p := new(StockReason)
+	*p = x
+	return p
+}
+
+func (x StockReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StockReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[1].Descriptor()
+}
+
+func (StockReason) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[1]
+}
+
+func (x StockReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StockReason.Descriptor instead.
+func (StockReason) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+// Режим загрузки деталей
+type ImportMode int32
+
+const (
+	ImportMode_IMPORT_MODE_UNSPECIFIED ImportMode = 0 // Не указан
+	ImportMode_IMPORT_MODE_CREATE      ImportMode = 1 // Только новые детали, существующие отклоняются
+	ImportMode_IMPORT_MODE_UPSERT      ImportMode = 2 // Новые детали создаются, существующие заменяются
+)
+
+// Enum value maps for ImportMode.
+var (
+	ImportMode_name = map[int32]string{
+		0: "IMPORT_MODE_UNSPECIFIED",
+		1: "IMPORT_MODE_CREATE",
+		2: "IMPORT_MODE_UPSERT",
+	}
+	ImportMode_value = map[string]int32{
+		"IMPORT_MODE_UNSPECIFIED": 0,
+		"IMPORT_MODE_CREATE":      1,
+		"IMPORT_MODE_UPSERT":      2,
+	}
+)
+
+func (x ImportMode) Enum() *ImportMode {
+	p := new(ImportMode)
+	*p = x
+	return p
+}
+
+func (x ImportMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[2].Descriptor()
+}
+
+func (ImportMode) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[2]
+}
+
+func (x ImportMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportMode.Descriptor instead.
+func (ImportMode) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+// Результат загрузки детали
+type ImportRowStatus int32
+
+const (
+	ImportRowStatus_IMPORT_ROW_STATUS_UNSPECIFIED ImportRowStatus = 0 // Неизвестный результат
+	ImportRowStatus_IMPORT_ROW_STATUS_CREATED     ImportRowStatus = 1 // Деталь создана
+	ImportRowStatus_IMPORT_ROW_STATUS_UPDATED     ImportRowStatus = 2 // Деталь заменена
+	ImportRowStatus_IMPORT_ROW_STATUS_REJECTED    ImportRowStatus = 3 // Деталь отклонена
+)
+
+// Enum value maps for ImportRowStatus.
+var (
+	ImportRowStatus_name = map[int32]string{
+		0: "IMPORT_ROW_STATUS_UNSPECIFIED",
+		1: "IMPORT_ROW_STATUS_CREATED",
+		2: "IMPORT_ROW_STATUS_UPDATED",
+		3: "IMPORT_ROW_STATUS_REJECTED",
+	}
+	ImportRowStatus_value = map[string]int32{
+		"IMPORT_ROW_STATUS_UNSPECIFIED": 0,
+		"IMPORT_ROW_STATUS_CREATED":     1,
+		"IMPORT_ROW_STATUS_UPDATED":     2,
+		"IMPORT_ROW_STATUS_REJECTED":    3,
+	}
+)
+
+func (x ImportRowStatus) Enum() *ImportRowStatus {
+	p := new(ImportRowStatus)
+	*p = x
+	return p
+}
+
+func (x ImportRowStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportRowStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[3].Descriptor()
+}
+
+func (ImportRowStatus) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[3]
+}
+
+func (x ImportRowStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportRowStatus.Descriptor instead.
+func (ImportRowStatus) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+// Вид правила совместимости
+type RuleKind int32
+
+const (
+	RuleKind_RULE_KIND_UNSPECIFIED RuleKind = 0 // Неизвестный вид
+	RuleKind_RULE_KIND_REQUIRES    RuleKind = 1 // Каждой детали subject нужна другая деталь target
+	RuleKind_RULE_KIND_EXCLUDES    RuleKind = 2 // Детали subject нельзя сочетать с другими деталями target
+	RuleKind_RULE_KIND_AT_LEAST    RuleKind = 3 // В наборе должно быть не менее min_count деталей target
+)
+
+// Enum value maps for RuleKind.
+var (
+	RuleKind_name = map[int32]string{
+		0: "RULE_KIND_UNSPECIFIED",
+		1: "RULE_KIND_REQUIRES",
+		2: "RULE_KIND_EXCLUDES",
+		3: "RULE_KIND_AT_LEAST",
+	}
+	RuleKind_value = map[string]int32{
+		"RULE_KIND_UNSPECIFIED": 0,
+		"RULE_KIND_REQUIRES":    1,
+		"RULE_KIND_EXCLUDES":    2,
+		"RULE_KIND_AT_LEAST":    3,
+	}
+)
+
+func (x RuleKind) Enum() *RuleKind {
+	p := new(RuleKind)
+	*p = x
+	return p
+}
+
+func (x RuleKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RuleKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[4].Descriptor()
+}
+
+func (RuleKind) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[4]
+}
+
+func (x RuleKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RuleKind.Descriptor instead.
+func (RuleKind) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{4}
+}
+
 // Category определяет возможные способы оплаты.
 type Category int32
 
@@ -62,11 +327,11 @@ func (x Category) String() string {
 }
 
 func (Category) Descriptor() protoreflect.EnumDescriptor {
-	return file_inventory_v1_inventory_proto_enumTypes[0].Descriptor()
+	return file_inventory_v1_inventory_proto_enumTypes[5].Descriptor()
 }
 
 func (Category) Type() protoreflect.EnumType {
-	return &file_inventory_v1_inventory_proto_enumTypes[0]
+	return &file_inventory_v1_inventory_proto_enumTypes[5]
 }
 
 func (x Category) Number() protoreflect.EnumNumber {
@@ -75,7 +340,7 @@ func (x Category) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Category.Descriptor instead.
 func (Category) EnumDescriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{5}
 }
 
 // Запрос для получения детали по UUID
@@ -86,21 +351,1828 @@ type GetPartRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPartRequest) Reset() {
-	*x = GetPartRequest{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[0]
+func (x *GetPartRequest) Reset() {
+	*x = GetPartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartRequest) ProtoMessage() {}
+
+func (x *GetPartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartRequest.ProtoReflect.Descriptor instead.
+func (*GetPartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetPartRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// Ответ с информацией о детали
+type GetPartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Part          *Part                  `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPartResponse) Reset() {
+	*x = GetPartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartResponse) ProtoMessage() {}
+
+func (x *GetPartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartResponse.ProtoReflect.Descriptor instead.
+func (*GetPartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetPartResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// Запрос для получения списка деталей с фильтрацией
+type ListPartsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *PartsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Максимальное количество деталей на странице, по умолчанию 50, не более 1000
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Токен страницы из next_page_token предыдущего ответа, пустой для первой страницы.
+	// Фильтр, выражение фильтра и сортировка должны совпадать с запросом первой страницы,
+	// иначе токен отклоняется с INVALID_ARGUMENT
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Поле сортировки и необязательное направление через пробел, например "price desc".
+	// Поля: name, price, stock, created_at. Направления: asc (по умолчанию), desc.
+	// Детали с одинаковым значением поля упорядочены по UUID, без сортировки - только по UUID
+	OrderBy string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Необязательное выражение фильтра на языке CEL, например
+	// thrust_kn > 900.0 && fuel_type == "LOX" или dimensions.weight < 500.0 && "space" in tags.
+	// Доступны поля uuid, name, description, price, stock_quantity, category, dimensions, manufacturer,
+	// tags, created_at, updated_at и metadata, а ключи метаданных - также как переменные своего типа.
+	// Деталь, у которой нет упомянутого ключа метаданных, не удовлетворяет выражению.
+	// Выражение объединяется с filter по И
+	FilterExpression string `protobuf:"bytes,5,opt,name=filter_expression,json=filterExpression,proto3" json:"filter_expression,omitempty"`
+	// Запрос фасетов - количеств деталей по значениям полей. Без него фасеты не вычисляются
+	Facets        *FacetsRequest `protobuf:"bytes,6,opt,name=facets,proto3" json:"facets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPartsRequest) Reset() {
+	*x = ListPartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPartsRequest) ProtoMessage() {}
+
+func (x *ListPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPartsRequest.ProtoReflect.Descriptor instead.
+func (*ListPartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListPartsRequest) GetFilter() *PartsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListPartsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPartsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPartsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListPartsRequest) GetFilterExpression() string {
+	if x != nil {
+		return x.FilterExpression
+	}
+	return ""
+}
+
+func (x *ListPartsRequest) GetFacets() *FacetsRequest {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+// Параметры фасетов списка деталей
+type FacetsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Максимальное количество значений в фасетах стран и тегов, по умолчанию 20, не более 100
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Границы ценовых корзин по возрастанию, по умолчанию 100, 1000, 10000, 100000.
+	// Корзина включает нижнюю границу и не включает верхнюю
+	PriceBounds   []float64 `protobuf:"fixed64,2,rep,packed,name=price_bounds,json=priceBounds,proto3" json:"price_bounds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetsRequest) Reset() {
+	*x = FacetsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetsRequest) ProtoMessage() {}
+
+func (x *FacetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetsRequest.ProtoReflect.Descriptor instead.
+func (*FacetsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FacetsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *FacetsRequest) GetPriceBounds() []float64 {
+	if x != nil {
+		return x.PriceBounds
+	}
+	return nil
+}
+
+// Ответ со списком деталей
+type ListPartsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Parts []*Part                `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	// Токен следующей страницы, пустой на последней странице
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Количество деталей, удовлетворяющих фильтру, на всех страницах
+	TotalSize int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// Фасеты, если они были запрошены
+	Facets        *Facets `protobuf:"bytes,4,opt,name=facets,proto3" json:"facets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPartsResponse) Reset() {
+	*x = ListPartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPartsResponse) ProtoMessage() {}
+
+func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPartsResponse.ProtoReflect.Descriptor instead.
+func (*ListPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListPartsResponse) GetParts() []*Part {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *ListPartsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListPartsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+func (x *ListPartsResponse) GetFacets() *Facets {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+// Количества деталей по значениям полей.
+// Каждый фасет считается по деталям, удовлетворяющим фильтру и выражению без условия на само поле фасета,
+// чтобы показывать, сколько деталей добавит выбор еще одного значения.
+// Значения упорядочены по убыванию количества, ценовые корзины - по возрастанию цены
+type Facets struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Categories            []*CategoryFacet       `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`                                                    // По категориям
+	ManufacturerCountries []*FacetValue          `protobuf:"bytes,2,rep,name=manufacturer_countries,json=manufacturerCountries,proto3" json:"manufacturer_countries,omitempty"` // По странам производителей
+	Tags                  []*FacetValue          `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`                                                                // По тегам
+	PriceBuckets          []*PriceBucket         `protobuf:"bytes,4,rep,name=price_buckets,json=priceBuckets,proto3" json:"price_buckets,omitempty"`                            // По ценовым корзинам
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Facets) Reset() {
+	*x = Facets{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Facets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Facets) ProtoMessage() {}
+
+func (x *Facets) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Facets.ProtoReflect.Descriptor instead.
+func (*Facets) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Facets) GetCategories() []*CategoryFacet {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *Facets) GetManufacturerCountries() []*FacetValue {
+	if x != nil {
+		return x.ManufacturerCountries
+	}
+	return nil
+}
+
+func (x *Facets) GetTags() []*FacetValue {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Facets) GetPriceBuckets() []*PriceBucket {
+	if x != nil {
+		return x.PriceBuckets
+	}
+	return nil
+}
+
+// Количество деталей категории
+type CategoryFacet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      Category               `protobuf:"varint,1,opt,name=category,proto3,enum=inventory.v1.Category" json:"category,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryFacet) Reset() {
+	*x = CategoryFacet{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryFacet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryFacet) ProtoMessage() {}
+
+func (x *CategoryFacet) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryFacet.ProtoReflect.Descriptor instead.
+func (*CategoryFacet) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CategoryFacet) GetCategory() Category {
+	if x != nil {
+		return x.Category
+	}
+	return Category_CATEGORY_UNSPECIFIED
+}
+
+func (x *CategoryFacet) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Количество деталей со значением поля, без учета регистра
+type FacetValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetValue) Reset() {
+	*x = FacetValue{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetValue) ProtoMessage() {}
+
+func (x *FacetValue) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetValue.ProtoReflect.Descriptor instead.
+func (*FacetValue) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FacetValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FacetValue) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Количество деталей в ценовой корзине [min, max). Отсутствующая граница не ограничивает цену
+type PriceBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           *float64               `protobuf:"fixed64,1,opt,name=min,proto3,oneof" json:"min,omitempty"` // Нижняя граница
+	Max           *float64               `protobuf:"fixed64,2,opt,name=max,proto3,oneof" json:"max,omitempty"` // Верхняя граница
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceBucket) Reset() {
+	*x = PriceBucket{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceBucket) ProtoMessage() {}
+
+func (x *PriceBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceBucket.ProtoReflect.Descriptor instead.
+func (*PriceBucket) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PriceBucket) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *PriceBucket) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+func (x *PriceBucket) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Запрос полнотекстового поиска деталей
+type SearchPartsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Поисковый запрос на русском или английском языке.
+	// Слова запроса находят слова, начинающиеся с них, и слова с опечатками.
+	// Запрос длиннее 256 байт или из более чем 16 слов отклоняется с INVALID_ARGUMENT
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Максимальное количество результатов, по умолчанию 20, не более 100
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Необязательный фильтр найденных деталей
+	Filter        *PartsFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPartsRequest) Reset() {
+	*x = SearchPartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPartsRequest) ProtoMessage() {}
+
+func (x *SearchPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPartsRequest.ProtoReflect.Descriptor instead.
+func (*SearchPartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SearchPartsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchPartsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchPartsRequest) GetFilter() *PartsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// Ответ с найденными деталями в порядке убывания релевантности
+type SearchPartsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Results []*SearchResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// Количество найденных деталей, удовлетворяющих фильтру, без учета page_size
+	TotalSize     int32 `protobuf:"varint,2,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPartsResponse) Reset() {
+	*x = SearchPartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPartsResponse) ProtoMessage() {}
+
+func (x *SearchPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPartsResponse.ProtoReflect.Descriptor instead.
+func (*SearchPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SearchPartsResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchPartsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+// Найденная деталь
+type SearchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Part          *Part                  `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"` // Релевантность детали запросу, больше - лучше
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SearchResult) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+func (x *SearchResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// Запрос подписки на изменения деталей.
+// Чтобы не пропустить изменения, подписку следует открыть до загрузки списка деталей через ListParts.
+// Если клиент не успевает читать события, поток завершается с RESOURCE_EXHAUSTED,
+// при обрыве соединения подписку можно продолжить с ревизии последнего полученного события
+type WatchPartsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Необязательный фильтр. Изменение передается, если фильтру удовлетворяет
+	// новая или прежняя версия детали, так что клиент узнает и о деталях, переставших ему удовлетворять
+	Filter *PartsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Ревизия, после которой нужно передать события, 0 - только новые события.
+	// Если события после нее уже не хранятся, поток завершается с OUT_OF_RANGE
+	// и список деталей нужно загрузить заново
+	FromRevision  uint64 `protobuf:"varint,2,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPartsRequest) Reset() {
+	*x = WatchPartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPartsRequest) ProtoMessage() {}
+
+func (x *WatchPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPartsRequest.ProtoReflect.Descriptor instead.
+func (*WatchPartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WatchPartsRequest) GetFilter() *PartsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *WatchPartsRequest) GetFromRevision() uint64 {
+	if x != nil {
+		return x.FromRevision
+	}
+	return 0
+}
+
+// Событие изменения детали
+type WatchPartsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ревизия события, возрастает с каждым изменением каталога
+	Revision uint64        `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Type     PartEventType `protobuf:"varint,2,opt,name=type,proto3,enum=inventory.v1.PartEventType" json:"type,omitempty"`
+	// Деталь после изменения, для удаления - последняя версия детали
+	Part          *Part `protobuf:"bytes,3,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPartsResponse) Reset() {
+	*x = WatchPartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPartsResponse) ProtoMessage() {}
+
+func (x *WatchPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPartsResponse.ProtoReflect.Descriptor instead.
+func (*WatchPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WatchPartsResponse) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *WatchPartsResponse) GetType() PartEventType {
+	if x != nil {
+		return x.Type
+	}
+	return PartEventType_PART_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WatchPartsResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// Запрос изменения количества детали на складе
+type AdjustStockRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Изменение количества, отрицательное - для списания. Не может быть нулевым,
+	// количество после изменения не может быть отрицательным
+	Delta int64 `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	// Причина изменения. Поступление увеличивает количество, продажа и списание уменьшают,
+	// корректировка может изменять количество в обе стороны
+	Reason StockReason `protobuf:"varint,3,opt,name=reason,proto3,enum=inventory.v1.StockReason" json:"reason,omitempty"`
+	// Кто изменяет количество, например логин сотрудника или имя сервиса
+	Actor string `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Ключ идемпотентности. Повторный запрос с тем же ключом не применяется еще раз,
+	// а возвращает записанное движение. Ключ не может использоваться для другого изменения
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AdjustStockRequest) Reset() {
+	*x = AdjustStockRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustStockRequest) ProtoMessage() {}
+
+func (x *AdjustStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustStockRequest.ProtoReflect.Descriptor instead.
+func (*AdjustStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AdjustStockRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *AdjustStockRequest) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *AdjustStockRequest) GetReason() StockReason {
+	if x != nil {
+		return x.Reason
+	}
+	return StockReason_STOCK_REASON_UNSPECIFIED
+}
+
+func (x *AdjustStockRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AdjustStockRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+// Ответ с записанным движением склада
+type AdjustStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movement      *StockMovement         `protobuf:"bytes,1,opt,name=movement,proto3" json:"movement,omitempty"`
+	Replayed      bool                   `protobuf:"varint,2,opt,name=replayed,proto3" json:"replayed,omitempty"` // Изменение с тем же ключом идемпотентности уже было применено
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustStockResponse) Reset() {
+	*x = AdjustStockResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustStockResponse) ProtoMessage() {}
+
+func (x *AdjustStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustStockResponse.ProtoReflect.Descriptor instead.
+func (*AdjustStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AdjustStockResponse) GetMovement() *StockMovement {
+	if x != nil {
+		return x.Movement
+	}
+	return nil
+}
+
+func (x *AdjustStockResponse) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
+// Запрос журнала движений склада
+type ListStockMovementsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID детали, пустой - движения всех деталей
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Максимальное количество движений на странице, по умолчанию 100, не более 1000
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Токен страницы из next_page_token предыдущего ответа, пустой для первой страницы
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockMovementsRequest) Reset() {
+	*x = ListStockMovementsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockMovementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockMovementsRequest) ProtoMessage() {}
+
+func (x *ListStockMovementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockMovementsRequest.ProtoReflect.Descriptor instead.
+func (*ListStockMovementsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListStockMovementsRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *ListStockMovementsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListStockMovementsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Ответ со страницей журнала движений склада
+type ListStockMovementsResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Movements []*StockMovement       `protobuf:"bytes,1,rep,name=movements,proto3" json:"movements,omitempty"`
+	// Токен следующей страницы, пустой на последней странице
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockMovementsResponse) Reset() {
+	*x = ListStockMovementsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockMovementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockMovementsResponse) ProtoMessage() {}
+
+func (x *ListStockMovementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockMovementsResponse.ProtoReflect.Descriptor instead.
+func (*ListStockMovementsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListStockMovementsResponse) GetMovements() []*StockMovement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+func (x *ListStockMovementsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Движение склада - запись журнала об изменении количества детали.
+// Создание детали с ненулевым количеством записывается как поступление,
+// замена детали с другим количеством - как корректировка без исполнителя
+type StockMovement struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Sequence       uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"` // Номер записи в журнале, возрастает с каждым движением
+	PartUuid       string                 `protobuf:"bytes,2,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	Delta          int64                  `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"` // Изменение количества
+	Reason         StockReason            `protobuf:"varint,4,opt,name=reason,proto3,enum=inventory.v1.StockReason" json:"reason,omitempty"`
+	Actor          string                 `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	QuantityAfter  int64                  `protobuf:"varint,7,opt,name=quantity_after,json=quantityAfter,proto3" json:"quantity_after,omitempty"` // Количество детали после движения
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StockMovement) Reset() {
+	*x = StockMovement{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockMovement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockMovement) ProtoMessage() {}
+
+func (x *StockMovement) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockMovement.ProtoReflect.Descriptor instead.
+func (*StockMovement) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *StockMovement) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *StockMovement) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *StockMovement) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *StockMovement) GetReason() StockReason {
+	if x != nil {
+		return x.Reason
+	}
+	return StockReason_STOCK_REASON_UNSPECIFIED
+}
+
+func (x *StockMovement) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *StockMovement) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *StockMovement) GetQuantityAfter() int64 {
+	if x != nil {
+		return x.QuantityAfter
+	}
+	return 0
+}
+
+func (x *StockMovement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Пакет загружаемых деталей
+type ImportPartsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Режим загрузки. Задается в первом пакете, в остальных может быть не указан, но не может отличаться
+	Mode ImportMode `protobuf:"varint,1,opt,name=mode,proto3,enum=inventory.v1.ImportMode" json:"mode,omitempty"`
+	// Детали пакета, не более 1000. Время создания и изменения проставляется сервером
+	Parts         []*Part `protobuf:"bytes,2,rep,name=parts,proto3" json:"parts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportPartsRequest) Reset() {
+	*x = ImportPartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportPartsRequest) ProtoMessage() {}
+
+func (x *ImportPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportPartsRequest.ProtoReflect.Descriptor instead.
+func (*ImportPartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ImportPartsRequest) GetMode() ImportMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ImportMode_IMPORT_MODE_UNSPECIFIED
+}
+
+func (x *ImportPartsRequest) GetParts() []*Part {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+// Отчет о загрузке деталей
+type ImportPartsResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Created  int32                  `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`   // Количество созданных деталей
+	Updated  int32                  `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`   // Количество замененных деталей
+	Rejected int32                  `protobuf:"varint,3,opt,name=rejected,proto3" json:"rejected,omitempty"` // Количество отклоненных деталей
+	// Результат по каждой детали в порядке загрузки
+	Rows          []*ImportRowResult `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportPartsResponse) Reset() {
+	*x = ImportPartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportPartsResponse) ProtoMessage() {}
+
+func (x *ImportPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportPartsResponse.ProtoReflect.Descriptor instead.
+func (*ImportPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ImportPartsResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportPartsResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportPartsResponse) GetRejected() int32 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *ImportPartsResponse) GetRows() []*ImportRowResult {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+// Результат загрузки одной детали
+type ImportRowResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"` // Номер детали в загрузке, начиная с 1
+	PartUuid      string                 `protobuf:"bytes,2,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	Status        ImportRowStatus        `protobuf:"varint,3,opt,name=status,proto3,enum=inventory.v1.ImportRowStatus" json:"status,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"` // Причина отклонения
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowResult) Reset() {
+	*x = ImportRowResult{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowResult) ProtoMessage() {}
+
+func (x *ImportRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowResult.ProtoReflect.Descriptor instead.
+func (*ImportRowResult) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ImportRowResult) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetStatus() ImportRowStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ImportRowStatus_IMPORT_ROW_STATUS_UNSPECIFIED
+}
+
+func (x *ImportRowResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Запрос выгрузки деталей
+type ExportPartsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *PartsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Количество деталей в пакете, по умолчанию 500, не более 1000
+	BatchSize     int32 `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportPartsRequest) Reset() {
+	*x = ExportPartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPartsRequest) ProtoMessage() {}
+
+func (x *ExportPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPartsRequest.ProtoReflect.Descriptor instead.
+func (*ExportPartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExportPartsRequest) GetFilter() *PartsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ExportPartsRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+// Пакет выгружаемых деталей
+type ExportPartsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Parts         []*Part                `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportPartsResponse) Reset() {
+	*x = ExportPartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPartsResponse) ProtoMessage() {}
+
+func (x *ExportPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPartsResponse.ProtoReflect.Descriptor instead.
+func (*ExportPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ExportPartsResponse) GetParts() []*Part {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+// Запрос добавления правила совместимости
+type CreateCompatibilityRuleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Правило. Пустой id назначается сервером
+	Rule          *CompatibilityRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCompatibilityRuleRequest) Reset() {
+	*x = CreateCompatibilityRuleRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCompatibilityRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompatibilityRuleRequest) ProtoMessage() {}
+
+func (x *CreateCompatibilityRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCompatibilityRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateCompatibilityRuleRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateCompatibilityRuleRequest) GetRule() *CompatibilityRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// Ответ с добавленным правилом совместимости
+type CreateCompatibilityRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *CompatibilityRule     `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCompatibilityRuleResponse) Reset() {
+	*x = CreateCompatibilityRuleResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCompatibilityRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompatibilityRuleResponse) ProtoMessage() {}
+
+func (x *CreateCompatibilityRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCompatibilityRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateCompatibilityRuleResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateCompatibilityRuleResponse) GetRule() *CompatibilityRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// Запрос списка правил совместимости
+type ListCompatibilityRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompatibilityRulesRequest) Reset() {
+	*x = ListCompatibilityRulesRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompatibilityRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompatibilityRulesRequest) ProtoMessage() {}
+
+func (x *ListCompatibilityRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompatibilityRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListCompatibilityRulesRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{26}
+}
+
+// Ответ со списком правил совместимости
+type ListCompatibilityRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*CompatibilityRule   `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompatibilityRulesResponse) Reset() {
+	*x = ListCompatibilityRulesResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompatibilityRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompatibilityRulesResponse) ProtoMessage() {}
+
+func (x *ListCompatibilityRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompatibilityRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListCompatibilityRulesResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListCompatibilityRulesResponse) GetRules() []*CompatibilityRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// Запрос удаления правила совместимости
+type DeleteCompatibilityRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCompatibilityRuleRequest) Reset() {
+	*x = DeleteCompatibilityRuleRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCompatibilityRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCompatibilityRuleRequest) ProtoMessage() {}
+
+func (x *DeleteCompatibilityRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCompatibilityRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCompatibilityRuleRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteCompatibilityRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Ответ на удаление правила совместимости
+type DeleteCompatibilityRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCompatibilityRuleResponse) Reset() {
+	*x = DeleteCompatibilityRuleResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCompatibilityRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCompatibilityRuleResponse) ProtoMessage() {}
+
+func (x *DeleteCompatibilityRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCompatibilityRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCompatibilityRuleResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{29}
+}
+
+// Запрос проверки набора деталей
+type ValidateConfigurationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID деталей набора. Деталь, указанная несколько раз, учитывается как несколько экземпляров
+	PartUuids     []string `protobuf:"bytes,1,rep,name=part_uuids,json=partUuids,proto3" json:"part_uuids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateConfigurationRequest) Reset() {
+	*x = ValidateConfigurationRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateConfigurationRequest) ProtoMessage() {}
+
+func (x *ValidateConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*ValidateConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ValidateConfigurationRequest) GetPartUuids() []string {
+	if x != nil {
+		return x.PartUuids
+	}
+	return nil
+}
+
+// Результат проверки набора деталей
+type ValidateConfigurationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Valid bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"` // Набор не нарушает ни одного правила
+	// Нарушенные правила в порядке их добавления
+	Violations    []*RuleViolation `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateConfigurationResponse) Reset() {
+	*x = ValidateConfigurationResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPartRequest) String() string {
+func (x *ValidateConfigurationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPartRequest) ProtoMessage() {}
+func (*ValidateConfigurationResponse) ProtoMessage() {}
 
-func (x *GetPartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[0]
+func (x *ValidateConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -111,41 +2183,52 @@ func (x *GetPartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPartRequest.ProtoReflect.Descriptor instead.
-func (*GetPartRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ValidateConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*ValidateConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetPartRequest) GetUuid() string {
+func (x *ValidateConfigurationResponse) GetValid() bool {
 	if x != nil {
-		return x.Uuid
+		return x.Valid
 	}
-	return ""
+	return false
 }
 
-// Ответ с информацией о детали
-type GetPartResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Part          *Part                  `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+func (x *ValidateConfigurationResponse) GetViolations() []*RuleViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+// Нарушение правила совместимости
+type RuleViolation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Rule  *CompatibilityRule     `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	// UUID деталей набора, нарушающих правило
+	PartUuids []string `protobuf:"bytes,2,rep,name=part_uuids,json=partUuids,proto3" json:"part_uuids,omitempty"`
+	// Описание нарушения, например "ENGINE tagged methane requires FUEL tagged methane"
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPartResponse) Reset() {
-	*x = GetPartResponse{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[1]
+func (x *RuleViolation) Reset() {
+	*x = RuleViolation{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPartResponse) String() string {
+func (x *RuleViolation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPartResponse) ProtoMessage() {}
+func (*RuleViolation) ProtoMessage() {}
 
-func (x *GetPartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[1]
+func (x *RuleViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -156,41 +2239,64 @@ func (x *GetPartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPartResponse.ProtoReflect.Descriptor instead.
-func (*GetPartResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use RuleViolation.ProtoReflect.Descriptor instead.
+func (*RuleViolation) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetPartResponse) GetPart() *Part {
+func (x *RuleViolation) GetRule() *CompatibilityRule {
 	if x != nil {
-		return x.Part
+		return x.Rule
 	}
 	return nil
 }
 
-// Запрос для получения списка деталей с фильтрацией
-type ListPartsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filter        *PartsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+func (x *RuleViolation) GetPartUuids() []string {
+	if x != nil {
+		return x.PartUuids
+	}
+	return nil
+}
+
+func (x *RuleViolation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Правило совместимости деталей
+type CompatibilityRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind  RuleKind               `protobuf:"varint,2,opt,name=kind,proto3,enum=inventory.v1.RuleKind" json:"kind,omitempty"`
+	// Детали, к которым применяется правило. Может быть пустым только для RULE_KIND_AT_LEAST,
+	// тогда правило применяется к любому набору
+	Subject *PartSelector `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	// Детали, которые требуются, запрещены или подсчитываются
+	Target *PartSelector `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	// Минимальное количество деталей target, только для RULE_KIND_AT_LEAST
+	MinCount      int32  `protobuf:"varint,5,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	Description   string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"` // Пояснение для покупателя
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPartsRequest) Reset() {
-	*x = ListPartsRequest{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[2]
+func (x *CompatibilityRule) Reset() {
+	*x = CompatibilityRule{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPartsRequest) String() string {
+func (x *CompatibilityRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPartsRequest) ProtoMessage() {}
+func (*CompatibilityRule) ProtoMessage() {}
 
-func (x *ListPartsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[2]
+func (x *CompatibilityRule) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -201,41 +2307,78 @@ func (x *ListPartsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPartsRequest.ProtoReflect.Descriptor instead.
-func (*ListPartsRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use CompatibilityRule.ProtoReflect.Descriptor instead.
+func (*CompatibilityRule) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ListPartsRequest) GetFilter() *PartsFilter {
+func (x *CompatibilityRule) GetId() string {
 	if x != nil {
-		return x.Filter
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CompatibilityRule) GetKind() RuleKind {
+	if x != nil {
+		return x.Kind
+	}
+	return RuleKind_RULE_KIND_UNSPECIFIED
+}
+
+func (x *CompatibilityRule) GetSubject() *PartSelector {
+	if x != nil {
+		return x.Subject
 	}
 	return nil
 }
 
-// Ответ со списком деталей
-type ListPartsResponse struct {
+func (x *CompatibilityRule) GetTarget() *PartSelector {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *CompatibilityRule) GetMinCount() int32 {
+	if x != nil {
+		return x.MinCount
+	}
+	return 0
+}
+
+func (x *CompatibilityRule) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Выбор деталей для правила совместимости. Деталь подходит, если совпадают все заданные поля
+type PartSelector struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Parts         []*Part                `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	PartUuid      string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`             // UUID детали, пустой - любая деталь
+	Category      Category               `protobuf:"varint,2,opt,name=category,proto3,enum=inventory.v1.Category" json:"category,omitempty"` // Категория, не указана - любая категория
+	Tag           string                 `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`                                       // Тег без учета регистра, пустой - любые теги
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPartsResponse) Reset() {
-	*x = ListPartsResponse{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
+func (x *PartSelector) Reset() {
+	*x = PartSelector{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPartsResponse) String() string {
+func (x *PartSelector) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPartsResponse) ProtoMessage() {}
+func (*PartSelector) ProtoMessage() {}
 
-func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
+func (x *PartSelector) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -246,16 +2389,30 @@ func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPartsResponse.ProtoReflect.Descriptor instead.
-func (*ListPartsResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use PartSelector.ProtoReflect.Descriptor instead.
+func (*PartSelector) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ListPartsResponse) GetParts() []*Part {
+func (x *PartSelector) GetPartUuid() string {
 	if x != nil {
-		return x.Parts
+		return x.PartUuid
 	}
-	return nil
+	return ""
+}
+
+func (x *PartSelector) GetCategory() Category {
+	if x != nil {
+		return x.Category
+	}
+	return Category_CATEGORY_UNSPECIFIED
+}
+
+func (x *PartSelector) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
 }
 
 // Фильтр для списка деталей
@@ -266,13 +2423,20 @@ type PartsFilter struct {
 	Categories            []Category             `protobuf:"varint,3,rep,packed,name=categories,proto3,enum=inventory.v1.Category" json:"categories,omitempty"`                 // Список категорий
 	ManufacturerCountries []string               `protobuf:"bytes,4,rep,name=manufacturer_countries,json=manufacturerCountries,proto3" json:"manufacturer_countries,omitempty"` // Список стран производителей
 	Tags                  []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`                                                                // Список тегов
+	Price                 *DoubleRange           `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`                                                              // Диапазон цены
+	StockQuantity         *Int64Range            `protobuf:"bytes,7,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`                         // Диапазон количества на складе
+	Length                *DoubleRange           `protobuf:"bytes,8,opt,name=length,proto3" json:"length,omitempty"`                                                            // Диапазон длины в см
+	Width                 *DoubleRange           `protobuf:"bytes,9,opt,name=width,proto3" json:"width,omitempty"`                                                              // Диапазон ширины в см
+	Height                *DoubleRange           `protobuf:"bytes,10,opt,name=height,proto3" json:"height,omitempty"`                                                           // Диапазон высоты в см
+	Weight                *DoubleRange           `protobuf:"bytes,11,opt,name=weight,proto3" json:"weight,omitempty"`                                                           // Диапазон веса в кг
+	InStockOnly           bool                   `protobuf:"varint,12,opt,name=in_stock_only,json=inStockOnly,proto3" json:"in_stock_only,omitempty"`                           // Только детали, которые есть на складе
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
 
 func (x *PartsFilter) Reset() {
 	*x = PartsFilter{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -284,7 +2448,7 @@ func (x *PartsFilter) String() string {
 func (*PartsFilter) ProtoMessage() {}
 
 func (x *PartsFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -297,7 +2461,7 @@ func (x *PartsFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PartsFilter.ProtoReflect.Descriptor instead.
 func (*PartsFilter) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{4}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *PartsFilter) GetUuids() []string {
@@ -335,6 +2499,161 @@ func (x *PartsFilter) GetTags() []string {
 	return nil
 }
 
+func (x *PartsFilter) GetPrice() *DoubleRange {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetStockQuantity() *Int64Range {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetLength() *DoubleRange {
+	if x != nil {
+		return x.Length
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetWidth() *DoubleRange {
+	if x != nil {
+		return x.Width
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetHeight() *DoubleRange {
+	if x != nil {
+		return x.Height
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetWeight() *DoubleRange {
+	if x != nil {
+		return x.Weight
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetInStockOnly() bool {
+	if x != nil {
+		return x.InStockOnly
+	}
+	return false
+}
+
+// Диапазон дробных значений, границы включаются. Отсутствующая граница не ограничивает значение
+type DoubleRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           *float64               `protobuf:"fixed64,1,opt,name=min,proto3,oneof" json:"min,omitempty"` // Нижняя граница
+	Max           *float64               `protobuf:"fixed64,2,opt,name=max,proto3,oneof" json:"max,omitempty"` // Верхняя граница
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DoubleRange) Reset() {
+	*x = DoubleRange{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoubleRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoubleRange) ProtoMessage() {}
+
+func (x *DoubleRange) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoubleRange.ProtoReflect.Descriptor instead.
+func (*DoubleRange) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DoubleRange) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *DoubleRange) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+// Диапазон целых значений, границы включаются. Отсутствующая граница не ограничивает значение
+type Int64Range struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           *int64                 `protobuf:"varint,1,opt,name=min,proto3,oneof" json:"min,omitempty"` // Нижняя граница
+	Max           *int64                 `protobuf:"varint,2,opt,name=max,proto3,oneof" json:"max,omitempty"` // Верхняя граница
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Int64Range) Reset() {
+	*x = Int64Range{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Int64Range) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Int64Range) ProtoMessage() {}
+
+func (x *Int64Range) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Int64Range.ProtoReflect.Descriptor instead.
+func (*Int64Range) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Int64Range) GetMin() int64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *Int64Range) GetMax() int64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
 // Физические размеры и вес
 type Dimensions struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -348,7 +2667,7 @@ type Dimensions struct {
 
 func (x *Dimensions) Reset() {
 	*x = Dimensions{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -360,7 +2679,7 @@ func (x *Dimensions) String() string {
 func (*Dimensions) ProtoMessage() {}
 
 func (x *Dimensions) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -373,7 +2692,7 @@ func (x *Dimensions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Dimensions.ProtoReflect.Descriptor instead.
 func (*Dimensions) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{5}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *Dimensions) GetLength() float64 {
@@ -416,7 +2735,7 @@ type Manufacturer struct {
 
 func (x *Manufacturer) Reset() {
 	*x = Manufacturer{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -428,7 +2747,7 @@ func (x *Manufacturer) String() string {
 func (*Manufacturer) ProtoMessage() {}
 
 func (x *Manufacturer) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -441,7 +2760,7 @@ func (x *Manufacturer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Manufacturer.ProtoReflect.Descriptor instead.
 func (*Manufacturer) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{6}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *Manufacturer) GetName() string {
@@ -481,7 +2800,7 @@ type Value struct {
 
 func (x *Value) Reset() {
 	*x = Value{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -493,7 +2812,7 @@ func (x *Value) String() string {
 func (*Value) ProtoMessage() {}
 
 func (x *Value) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -506,7 +2825,7 @@ func (x *Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Value.ProtoReflect.Descriptor instead.
 func (*Value) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{7}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *Value) GetKind() isValue_Kind {
@@ -601,7 +2920,7 @@ type Part struct {
 
 func (x *Part) Reset() {
 	*x = Part{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -613,7 +2932,7 @@ func (x *Part) String() string {
 func (*Part) ProtoMessage() {}
 
 func (x *Part) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -626,7 +2945,7 @@ func (x *Part) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Part.ProtoReflect.Descriptor instead.
 func (*Part) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{8}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *Part) GetUuid() string {
@@ -721,11 +3040,142 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\x0eGetPartRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"9\n" +
 	"\x0fGetPartResponse\x12&\n" +
-	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"E\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"\xfe\x01\n" +
 	"\x10ListPartsRequest\x121\n" +
-	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\"=\n" +
+	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\x12+\n" +
+	"\x11filter_expression\x18\x05 \x01(\tR\x10filterExpression\x123\n" +
+	"\x06facets\x18\x06 \x01(\v2\x1b.inventory.v1.FacetsRequestR\x06facets\"H\n" +
+	"\rFacetsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12!\n" +
+	"\fprice_bounds\x18\x02 \x03(\x01R\vpriceBounds\"\xb2\x01\n" +
 	"\x11ListPartsResponse\x12(\n" +
-	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\"\xbc\x01\n" +
+	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\x12,\n" +
+	"\x06facets\x18\x04 \x01(\v2\x14.inventory.v1.FacetsR\x06facets\"\x84\x02\n" +
+	"\x06Facets\x12;\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x1b.inventory.v1.CategoryFacetR\n" +
+	"categories\x12O\n" +
+	"\x16manufacturer_countries\x18\x02 \x03(\v2\x18.inventory.v1.FacetValueR\x15manufacturerCountries\x12,\n" +
+	"\x04tags\x18\x03 \x03(\v2\x18.inventory.v1.FacetValueR\x04tags\x12>\n" +
+	"\rprice_buckets\x18\x04 \x03(\v2\x19.inventory.v1.PriceBucketR\fpriceBuckets\"Y\n" +
+	"\rCategoryFacet\x122\n" +
+	"\bcategory\x18\x01 \x01(\x0e2\x16.inventory.v1.CategoryR\bcategory\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"8\n" +
+	"\n" +
+	"FacetValue\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"a\n" +
+	"\vPriceBucket\x12\x15\n" +
+	"\x03min\x18\x01 \x01(\x01H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x02 \x01(\x01H\x01R\x03max\x88\x01\x01\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05countB\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"z\n" +
+	"\x12SearchPartsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x121\n" +
+	"\x06filter\x18\x03 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\"j\n" +
+	"\x13SearchPartsResponse\x124\n" +
+	"\aresults\x18\x01 \x03(\v2\x1a.inventory.v1.SearchResultR\aresults\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x02 \x01(\x05R\ttotalSize\"L\n" +
+	"\fSearchResult\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\"k\n" +
+	"\x11WatchPartsRequest\x121\n" +
+	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\x12#\n" +
+	"\rfrom_revision\x18\x02 \x01(\x04R\ffromRevision\"\x89\x01\n" +
+	"\x12WatchPartsResponse\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x04R\brevision\x12/\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1b.inventory.v1.PartEventTypeR\x04type\x12&\n" +
+	"\x04part\x18\x03 \x01(\v2\x12.inventory.v1.PartR\x04part\"\xb9\x01\n" +
+	"\x12AdjustStockRequest\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x14\n" +
+	"\x05delta\x18\x02 \x01(\x03R\x05delta\x121\n" +
+	"\x06reason\x18\x03 \x01(\x0e2\x19.inventory.v1.StockReasonR\x06reason\x12\x14\n" +
+	"\x05actor\x18\x04 \x01(\tR\x05actor\x12'\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tR\x0eidempotencyKey\"j\n" +
+	"\x13AdjustStockResponse\x127\n" +
+	"\bmovement\x18\x01 \x01(\v2\x1b.inventory.v1.StockMovementR\bmovement\x12\x1a\n" +
+	"\breplayed\x18\x02 \x01(\bR\breplayed\"t\n" +
+	"\x19ListStockMovementsRequest\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x7f\n" +
+	"\x1aListStockMovementsResponse\x129\n" +
+	"\tmovements\x18\x01 \x03(\v2\x1b.inventory.v1.StockMovementR\tmovements\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xb2\x02\n" +
+	"\rStockMovement\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x12\x1b\n" +
+	"\tpart_uuid\x18\x02 \x01(\tR\bpartUuid\x12\x14\n" +
+	"\x05delta\x18\x03 \x01(\x03R\x05delta\x121\n" +
+	"\x06reason\x18\x04 \x01(\x0e2\x19.inventory.v1.StockReasonR\x06reason\x12\x14\n" +
+	"\x05actor\x18\x05 \x01(\tR\x05actor\x12'\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tR\x0eidempotencyKey\x12%\n" +
+	"\x0equantity_after\x18\a \x01(\x03R\rquantityAfter\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"l\n" +
+	"\x12ImportPartsRequest\x12,\n" +
+	"\x04mode\x18\x01 \x01(\x0e2\x18.inventory.v1.ImportModeR\x04mode\x12(\n" +
+	"\x05parts\x18\x02 \x03(\v2\x12.inventory.v1.PartR\x05parts\"\x98\x01\n" +
+	"\x13ImportPartsResponse\x12\x18\n" +
+	"\acreated\x18\x01 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x02 \x01(\x05R\aupdated\x12\x1a\n" +
+	"\brejected\x18\x03 \x01(\x05R\brejected\x121\n" +
+	"\x04rows\x18\x04 \x03(\v2\x1d.inventory.v1.ImportRowResultR\x04rows\"\x8d\x01\n" +
+	"\x0fImportRowResult\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x1b\n" +
+	"\tpart_uuid\x18\x02 \x01(\tR\bpartUuid\x125\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1d.inventory.v1.ImportRowStatusR\x06status\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"f\n" +
+	"\x12ExportPartsRequest\x121\n" +
+	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x02 \x01(\x05R\tbatchSize\"?\n" +
+	"\x13ExportPartsResponse\x12(\n" +
+	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\"U\n" +
+	"\x1eCreateCompatibilityRuleRequest\x123\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1f.inventory.v1.CompatibilityRuleR\x04rule\"V\n" +
+	"\x1fCreateCompatibilityRuleResponse\x123\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1f.inventory.v1.CompatibilityRuleR\x04rule\"\x1f\n" +
+	"\x1dListCompatibilityRulesRequest\"W\n" +
+	"\x1eListCompatibilityRulesResponse\x125\n" +
+	"\x05rules\x18\x01 \x03(\v2\x1f.inventory.v1.CompatibilityRuleR\x05rules\"0\n" +
+	"\x1eDeleteCompatibilityRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"!\n" +
+	"\x1fDeleteCompatibilityRuleResponse\"=\n" +
+	"\x1cValidateConfigurationRequest\x12\x1d\n" +
+	"\n" +
+	"part_uuids\x18\x01 \x03(\tR\tpartUuids\"r\n" +
+	"\x1dValidateConfigurationResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12;\n" +
+	"\n" +
+	"violations\x18\x02 \x03(\v2\x1b.inventory.v1.RuleViolationR\n" +
+	"violations\"}\n" +
+	"\rRuleViolation\x123\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1f.inventory.v1.CompatibilityRuleR\x04rule\x12\x1d\n" +
+	"\n" +
+	"part_uuids\x18\x02 \x03(\tR\tpartUuids\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xf8\x01\n" +
+	"\x11CompatibilityRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x16.inventory.v1.RuleKindR\x04kind\x124\n" +
+	"\asubject\x18\x03 \x01(\v2\x1a.inventory.v1.PartSelectorR\asubject\x122\n" +
+	"\x06target\x18\x04 \x01(\v2\x1a.inventory.v1.PartSelectorR\x06target\x12\x1b\n" +
+	"\tmin_count\x18\x05 \x01(\x05R\bminCount\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\"q\n" +
+	"\fPartSelector\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x122\n" +
+	"\bcategory\x18\x02 \x01(\x0e2\x16.inventory.v1.CategoryR\bcategory\x12\x10\n" +
+	"\x03tag\x18\x03 \x01(\tR\x03tag\"\x9c\x04\n" +
 	"\vPartsFilter\x12\x14\n" +
 	"\x05uuids\x18\x01 \x03(\tR\x05uuids\x12\x14\n" +
 	"\x05names\x18\x02 \x03(\tR\x05names\x126\n" +
@@ -733,7 +3183,26 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"categories\x18\x03 \x03(\x0e2\x16.inventory.v1.CategoryR\n" +
 	"categories\x125\n" +
 	"\x16manufacturer_countries\x18\x04 \x03(\tR\x15manufacturerCountries\x12\x12\n" +
-	"\x04tags\x18\x05 \x03(\tR\x04tags\"j\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12/\n" +
+	"\x05price\x18\x06 \x01(\v2\x19.inventory.v1.DoubleRangeR\x05price\x12?\n" +
+	"\x0estock_quantity\x18\a \x01(\v2\x18.inventory.v1.Int64RangeR\rstockQuantity\x121\n" +
+	"\x06length\x18\b \x01(\v2\x19.inventory.v1.DoubleRangeR\x06length\x12/\n" +
+	"\x05width\x18\t \x01(\v2\x19.inventory.v1.DoubleRangeR\x05width\x121\n" +
+	"\x06height\x18\n" +
+	" \x01(\v2\x19.inventory.v1.DoubleRangeR\x06height\x121\n" +
+	"\x06weight\x18\v \x01(\v2\x19.inventory.v1.DoubleRangeR\x06weight\x12\"\n" +
+	"\rin_stock_only\x18\f \x01(\bR\vinStockOnly\"K\n" +
+	"\vDoubleRange\x12\x15\n" +
+	"\x03min\x18\x01 \x01(\x01H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x02 \x01(\x01H\x01R\x03max\x88\x01\x01B\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"J\n" +
+	"\n" +
+	"Int64Range\x12\x15\n" +
+	"\x03min\x18\x01 \x01(\x03H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x02 \x01(\x03H\x01R\x03max\x88\x01\x01B\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"j\n" +
 	"\n" +
 	"Dimensions\x12\x16\n" +
 	"\x06length\x18\x01 \x01(\x01R\x06length\x12\x14\n" +
@@ -772,16 +3241,53 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1aP\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
-	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01*v\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01*\x87\x01\n" +
+	"\rPartEventType\x12\x1f\n" +
+	"\x1bPART_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17PART_EVENT_TYPE_CREATED\x10\x01\x12\x1b\n" +
+	"\x17PART_EVENT_TYPE_UPDATED\x10\x02\x12\x1b\n" +
+	"\x17PART_EVENT_TYPE_DELETED\x10\x03*\x92\x01\n" +
+	"\vStockReason\x12\x1c\n" +
+	"\x18STOCK_REASON_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14STOCK_REASON_RECEIPT\x10\x01\x12\x15\n" +
+	"\x11STOCK_REASON_SALE\x10\x02\x12\x17\n" +
+	"\x13STOCK_REASON_DAMAGE\x10\x03\x12\x1b\n" +
+	"\x17STOCK_REASON_CORRECTION\x10\x04*Y\n" +
+	"\n" +
+	"ImportMode\x12\x1b\n" +
+	"\x17IMPORT_MODE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12IMPORT_MODE_CREATE\x10\x01\x12\x16\n" +
+	"\x12IMPORT_MODE_UPSERT\x10\x02*\x92\x01\n" +
+	"\x0fImportRowStatus\x12!\n" +
+	"\x1dIMPORT_ROW_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19IMPORT_ROW_STATUS_CREATED\x10\x01\x12\x1d\n" +
+	"\x19IMPORT_ROW_STATUS_UPDATED\x10\x02\x12\x1e\n" +
+	"\x1aIMPORT_ROW_STATUS_REJECTED\x10\x03*m\n" +
+	"\bRuleKind\x12\x19\n" +
+	"\x15RULE_KIND_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12RULE_KIND_REQUIRES\x10\x01\x12\x16\n" +
+	"\x12RULE_KIND_EXCLUDES\x10\x02\x12\x16\n" +
+	"\x12RULE_KIND_AT_LEAST\x10\x03*v\n" +
 	"\bCategory\x12\x18\n" +
 	"\x14CATEGORY_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fCATEGORY_ENGINE\x10\x01\x12\x11\n" +
 	"\rCATEGORY_FUEL\x10\x02\x12\x15\n" +
 	"\x11CATEGORY_PORTHOLE\x10\x03\x12\x11\n" +
-	"\rCATEGORY_WING\x10\x042\xa8\x01\n" +
+	"\rCATEGORY_WING\x10\x042\x8f\t\n" +
 	"\x10InventoryService\x12F\n" +
 	"\aGetPart\x12\x1c.inventory.v1.GetPartRequest\x1a\x1d.inventory.v1.GetPartResponse\x12L\n" +
-	"\tListParts\x12\x1e.inventory.v1.ListPartsRequest\x1a\x1f.inventory.v1.ListPartsResponseBQZOgithub.com/andredubov/rocket-factory/shared/pkg/proto/inventory/v1;inventory_v1b\x06proto3"
+	"\tListParts\x12\x1e.inventory.v1.ListPartsRequest\x1a\x1f.inventory.v1.ListPartsResponse\x12R\n" +
+	"\vSearchParts\x12 .inventory.v1.SearchPartsRequest\x1a!.inventory.v1.SearchPartsResponse\x12Q\n" +
+	"\n" +
+	"WatchParts\x12\x1f.inventory.v1.WatchPartsRequest\x1a .inventory.v1.WatchPartsResponse0\x01\x12R\n" +
+	"\vAdjustStock\x12 .inventory.v1.AdjustStockRequest\x1a!.inventory.v1.AdjustStockResponse\x12g\n" +
+	"\x12ListStockMovements\x12'.inventory.v1.ListStockMovementsRequest\x1a(.inventory.v1.ListStockMovementsResponse\x12T\n" +
+	"\vImportParts\x12 .inventory.v1.ImportPartsRequest\x1a!.inventory.v1.ImportPartsResponse(\x01\x12T\n" +
+	"\vExportParts\x12 .inventory.v1.ExportPartsRequest\x1a!.inventory.v1.ExportPartsResponse0\x01\x12v\n" +
+	"\x17CreateCompatibilityRule\x12,.inventory.v1.CreateCompatibilityRuleRequest\x1a-.inventory.v1.CreateCompatibilityRuleResponse\x12s\n" +
+	"\x16ListCompatibilityRules\x12+.inventory.v1.ListCompatibilityRulesRequest\x1a,.inventory.v1.ListCompatibilityRulesResponse\x12v\n" +
+	"\x17DeleteCompatibilityRule\x12,.inventory.v1.DeleteCompatibilityRuleRequest\x1a-.inventory.v1.DeleteCompatibilityRuleResponse\x12p\n" +
+	"\x15ValidateConfiguration\x12*.inventory.v1.ValidateConfigurationRequest\x1a+.inventory.v1.ValidateConfigurationResponseBQZOgithub.com/andredubov/rocket-factory/shared/pkg/proto/inventory/v1;inventory_v1b\x06proto3"
 
 var (
 	file_inventory_v1_inventory_proto_rawDescOnce sync.Once
@@ -795,43 +3301,140 @@ func file_inventory_v1_inventory_proto_rawDescGZIP() []byte {
 	return file_inventory_v1_inventory_proto_rawDescData
 }
 
-var file_inventory_v1_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_inventory_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_inventory_v1_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_inventory_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_inventory_v1_inventory_proto_goTypes = []any{
-	(Category)(0),                 // 0: inventory.v1.Category
-	(*GetPartRequest)(nil),        // 1: inventory.v1.GetPartRequest
-	(*GetPartResponse)(nil),       // 2: inventory.v1.GetPartResponse
-	(*ListPartsRequest)(nil),      // 3: inventory.v1.ListPartsRequest
-	(*ListPartsResponse)(nil),     // 4: inventory.v1.ListPartsResponse
-	(*PartsFilter)(nil),           // 5: inventory.v1.PartsFilter
-	(*Dimensions)(nil),            // 6: inventory.v1.Dimensions
-	(*Manufacturer)(nil),          // 7: inventory.v1.Manufacturer
-	(*Value)(nil),                 // 8: inventory.v1.Value
-	(*Part)(nil),                  // 9: inventory.v1.Part
-	nil,                           // 10: inventory.v1.Part.MetadataEntry
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(PartEventType)(0),                      // 0: inventory.v1.PartEventType
+	(StockReason)(0),                        // 1: inventory.v1.StockReason
+	(ImportMode)(0),                         // 2: inventory.v1.ImportMode
+	(ImportRowStatus)(0),                    // 3: inventory.v1.ImportRowStatus
+	(RuleKind)(0),                           // 4: inventory.v1.RuleKind
+	(Category)(0),                           // 5: inventory.v1.Category
+	(*GetPartRequest)(nil),                  // 6: inventory.v1.GetPartRequest
+	(*GetPartResponse)(nil),                 // 7: inventory.v1.GetPartResponse
+	(*ListPartsRequest)(nil),                // 8: inventory.v1.ListPartsRequest
+	(*FacetsRequest)(nil),                   // 9: inventory.v1.FacetsRequest
+	(*ListPartsResponse)(nil),               // 10: inventory.v1.ListPartsResponse
+	(*Facets)(nil),                          // 11: inventory.v1.Facets
+	(*CategoryFacet)(nil),                   // 12: inventory.v1.CategoryFacet
+	(*FacetValue)(nil),                      // 13: inventory.v1.FacetValue
+	(*PriceBucket)(nil),                     // 14: inventory.v1.PriceBucket
+	(*SearchPartsRequest)(nil),              // 15: inventory.v1.SearchPartsRequest
+	(*SearchPartsResponse)(nil),             // 16: inventory.v1.SearchPartsResponse
+	(*SearchResult)(nil),                    // 17: inventory.v1.SearchResult
+	(*WatchPartsRequest)(nil),               // 18: inventory.v1.WatchPartsRequest
+	(*WatchPartsResponse)(nil),              // 19: inventory.v1.WatchPartsResponse
+	(*AdjustStockRequest)(nil),              // 20: inventory.v1.AdjustStockRequest
+	(*AdjustStockResponse)(nil),             // 21: inventory.v1.AdjustStockResponse
+	(*ListStockMovementsRequest)(nil),       // 22: inventory.v1.ListStockMovementsRequest
+	(*ListStockMovementsResponse)(nil),      // 23: inventory.v1.ListStockMovementsResponse
+	(*StockMovement)(nil),                   // 24: inventory.v1.StockMovement
+	(*ImportPartsRequest)(nil),              // 25: inventory.v1.ImportPartsRequest
+	(*ImportPartsResponse)(nil),             // 26: inventory.v1.ImportPartsResponse
+	(*ImportRowResult)(nil),                 // 27: inventory.v1.ImportRowResult
+	(*ExportPartsRequest)(nil),              // 28: inventory.v1.ExportPartsRequest
+	(*ExportPartsResponse)(nil),             // 29: inventory.v1.ExportPartsResponse
+	(*CreateCompatibilityRuleRequest)(nil),  // 30: inventory.v1.CreateCompatibilityRuleRequest
+	(*CreateCompatibilityRuleResponse)(nil), // 31: inventory.v1.CreateCompatibilityRuleResponse
+	(*ListCompatibilityRulesRequest)(nil),   // 32: inventory.v1.ListCompatibilityRulesRequest
+	(*ListCompatibilityRulesResponse)(nil),  // 33: inventory.v1.ListCompatibilityRulesResponse
+	(*DeleteCompatibilityRuleRequest)(nil),  // 34: inventory.v1.DeleteCompatibilityRuleRequest
+	(*DeleteCompatibilityRuleResponse)(nil), // 35: inventory.v1.DeleteCompatibilityRuleResponse
+	(*ValidateConfigurationRequest)(nil),    // 36: inventory.v1.ValidateConfigurationRequest
+	(*ValidateConfigurationResponse)(nil),   // 37: inventory.v1.ValidateConfigurationResponse
+	(*RuleViolation)(nil),                   // 38: inventory.v1.RuleViolation
+	(*CompatibilityRule)(nil),               // 39: inventory.v1.CompatibilityRule
+	(*PartSelector)(nil),                    // 40: inventory.v1.PartSelector
+	(*PartsFilter)(nil),                     // 41: inventory.v1.PartsFilter
+	(*DoubleRange)(nil),                     // 42: inventory.v1.DoubleRange
+	(*Int64Range)(nil),                      // 43: inventory.v1.Int64Range
+	(*Dimensions)(nil),                      // 44: inventory.v1.Dimensions
+	(*Manufacturer)(nil),                    // 45: inventory.v1.Manufacturer
+	(*Value)(nil),                           // 46: inventory.v1.Value
+	(*Part)(nil),                            // 47: inventory.v1.Part
+	nil,                                     // 48: inventory.v1.Part.MetadataEntry
+	(*timestamppb.Timestamp)(nil),           // 49: google.protobuf.Timestamp
 }
 var file_inventory_v1_inventory_proto_depIdxs = []int32{
-	9,  // 0: inventory.v1.GetPartResponse.part:type_name -> inventory.v1.Part
-	5,  // 1: inventory.v1.ListPartsRequest.filter:type_name -> inventory.v1.PartsFilter
-	9,  // 2: inventory.v1.ListPartsResponse.parts:type_name -> inventory.v1.Part
-	0,  // 3: inventory.v1.PartsFilter.categories:type_name -> inventory.v1.Category
-	0,  // 4: inventory.v1.Part.category:type_name -> inventory.v1.Category
-	6,  // 5: inventory.v1.Part.dimensions:type_name -> inventory.v1.Dimensions
-	7,  // 6: inventory.v1.Part.manufacturer:type_name -> inventory.v1.Manufacturer
-	10, // 7: inventory.v1.Part.metadata:type_name -> inventory.v1.Part.MetadataEntry
-	11, // 8: inventory.v1.Part.created_at:type_name -> google.protobuf.Timestamp
-	11, // 9: inventory.v1.Part.updated_at:type_name -> google.protobuf.Timestamp
-	8,  // 10: inventory.v1.Part.MetadataEntry.value:type_name -> inventory.v1.Value
-	1,  // 11: inventory.v1.InventoryService.GetPart:input_type -> inventory.v1.GetPartRequest
-	3,  // 12: inventory.v1.InventoryService.ListParts:input_type -> inventory.v1.ListPartsRequest
-	2,  // 13: inventory.v1.InventoryService.GetPart:output_type -> inventory.v1.GetPartResponse
-	4,  // 14: inventory.v1.InventoryService.ListParts:output_type -> inventory.v1.ListPartsResponse
-	13, // [13:15] is the sub-list for method output_type
-	11, // [11:13] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	47, // 0: inventory.v1.GetPartResponse.part:type_name -> inventory.v1.Part
+	41, // 1: inventory.v1.ListPartsRequest.filter:type_name -> inventory.v1.PartsFilter
+	9,  // 2: inventory.v1.ListPartsRequest.facets:type_name -> inventory.v1.FacetsRequest
+	47, // 3: inventory.v1.ListPartsResponse.parts:type_name -> inventory.v1.Part
+	11, // 4: inventory.v1.ListPartsResponse.facets:type_name -> inventory.v1.Facets
+	12, // 5: inventory.v1.Facets.categories:type_name -> inventory.v1.CategoryFacet
+	13, // 6: inventory.v1.Facets.manufacturer_countries:type_name -> inventory.v1.FacetValue
+	13, // 7: inventory.v1.Facets.tags:type_name -> inventory.v1.FacetValue
+	14, // 8: inventory.v1.Facets.price_buckets:type_name -> inventory.v1.PriceBucket
+	5,  // 9: inventory.v1.CategoryFacet.category:type_name -> inventory.v1.Category
+	41, // 10: inventory.v1.SearchPartsRequest.filter:type_name -> inventory.v1.PartsFilter
+	17, // 11: inventory.v1.SearchPartsResponse.results:type_name -> inventory.v1.SearchResult
+	47, // 12: inventory.v1.SearchResult.part:type_name -> inventory.v1.Part
+	41, // 13: inventory.v1.WatchPartsRequest.filter:type_name -> inventory.v1.PartsFilter
+	0,  // 14: inventory.v1.WatchPartsResponse.type:type_name -> inventory.v1.PartEventType
+	47, // 15: inventory.v1.WatchPartsResponse.part:type_name -> inventory.v1.Part
+	1,  // 16: inventory.v1.AdjustStockRequest.reason:type_name -> inventory.v1.StockReason
+	24, // 17: inventory.v1.AdjustStockResponse.movement:type_name -> inventory.v1.StockMovement
+	24, // 18: inventory.v1.ListStockMovementsResponse.movements:type_name -> inventory.v1.StockMovement
+	1,  // 19: inventory.v1.StockMovement.reason:type_name -> inventory.v1.StockReason
+	49, // 20: inventory.v1.StockMovement.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 21: inventory.v1.ImportPartsRequest.mode:type_name -> inventory.v1.ImportMode
+	47, // 22: inventory.v1.ImportPartsRequest.parts:type_name -> inventory.v1.Part
+	27, // 23: inventory.v1.ImportPartsResponse.rows:type_name -> inventory.v1.ImportRowResult
+	3,  // 24: inventory.v1.ImportRowResult.status:type_name -> inventory.v1.ImportRowStatus
+	41, // 25: inventory.v1.ExportPartsRequest.filter:type_name -> inventory.v1.PartsFilter
+	47, // 26: inventory.v1.ExportPartsResponse.parts:type_name -> inventory.v1.Part
+	39, // 27: inventory.v1.CreateCompatibilityRuleRequest.rule:type_name -> inventory.v1.CompatibilityRule
+	39, // 28: inventory.v1.CreateCompatibilityRuleResponse.rule:type_name -> inventory.v1.CompatibilityRule
+	39, // 29: inventory.v1.ListCompatibilityRulesResponse.rules:type_name -> inventory.v1.CompatibilityRule
+	38, // 30: inventory.v1.ValidateConfigurationResponse.violations:type_name -> inventory.v1.RuleViolation
+	39, // 31: inventory.v1.RuleViolation.rule:type_name -> inventory.v1.CompatibilityRule
+	4,  // 32: inventory.v1.CompatibilityRule.kind:type_name -> inventory.v1.RuleKind
+	40, // 33: inventory.v1.CompatibilityRule.subject:type_name -> inventory.v1.PartSelector
+	40, // 34: inventory.v1.CompatibilityRule.target:type_name -> inventory.v1.PartSelector
+	5,  // 35: inventory.v1.PartSelector.category:type_name -> inventory.v1.Category
+	5,  // 36: inventory.v1.PartsFilter.categories:type_name -> inventory.v1.Category
+	42, // 37: inventory.v1.PartsFilter.price:type_name -> inventory.v1.DoubleRange
+	43, // 38: inventory.v1.PartsFilter.stock_quantity:type_name -> inventory.v1.Int64Range
+	42, // 39: inventory.v1.PartsFilter.length:type_name -> inventory.v1.DoubleRange
+	42, // 40: inventory.v1.PartsFilter.width:type_name -> inventory.v1.DoubleRange
+	42, // 41: inventory.v1.PartsFilter.height:type_name -> inventory.v1.DoubleRange
+	42, // 42: inventory.v1.PartsFilter.weight:type_name -> inventory.v1.DoubleRange
+	5,  // 43: inventory.v1.Part.category:type_name -> inventory.v1.Category
+	44, // 44: inventory.v1.Part.dimensions:type_name -> inventory.v1.Dimensions
+	45, // 45: inventory.v1.Part.manufacturer:type_name -> inventory.v1.Manufacturer
+	48, // 46: inventory.v1.Part.metadata:type_name -> inventory.v1.Part.MetadataEntry
+	49, // 47: inventory.v1.Part.created_at:type_name -> google.protobuf.Timestamp
+	49, // 48: inventory.v1.Part.updated_at:type_name -> google.protobuf.Timestamp
+	46, // 49: inventory.v1.Part.MetadataEntry.value:type_name -> inventory.v1.Value
+	6,  // 50: inventory.v1.InventoryService.GetPart:input_type -> inventory.v1.GetPartRequest
+	8,  // 51: inventory.v1.InventoryService.ListParts:input_type -> inventory.v1.ListPartsRequest
+	15, // 52: inventory.v1.InventoryService.SearchParts:input_type -> inventory.v1.SearchPartsRequest
+	18, // 53: inventory.v1.InventoryService.WatchParts:input_type -> inventory.v1.WatchPartsRequest
+	20, // 54: inventory.v1.InventoryService.AdjustStock:input_type -> inventory.v1.AdjustStockRequest
+	22, // 55: inventory.v1.InventoryService.ListStockMovements:input_type -> inventory.v1.ListStockMovementsRequest
+	25, // 56: inventory.v1.InventoryService.ImportParts:input_type -> inventory.v1.ImportPartsRequest
+	28, // 57: inventory.v1.InventoryService.ExportParts:input_type -> inventory.v1.ExportPartsRequest
+	30, // 58: inventory.v1.InventoryService.CreateCompatibilityRule:input_type -> inventory.v1.CreateCompatibilityRuleRequest
+	32, // 59: inventory.v1.InventoryService.ListCompatibilityRules:input_type -> inventory.v1.ListCompatibilityRulesRequest
+	34, // 60: inventory.v1.InventoryService.DeleteCompatibilityRule:input_type -> inventory.v1.DeleteCompatibilityRuleRequest
+	36, // 61: inventory.v1.InventoryService.ValidateConfiguration:input_type -> inventory.v1.ValidateConfigurationRequest
+	7,  // 62: inventory.v1.InventoryService.GetPart:output_type -> inventory.v1.GetPartResponse
+	10, // 63: inventory.v1.InventoryService.ListParts:output_type -> inventory.v1.ListPartsResponse
+	16, // 64: inventory.v1.InventoryService.SearchParts:output_type -> inventory.v1.SearchPartsResponse
+	19, // 65: inventory.v1.InventoryService.WatchParts:output_type -> inventory.v1.WatchPartsResponse
+	21, // 66: inventory.v1.InventoryService.AdjustStock:output_type -> inventory.v1.AdjustStockResponse
+	23, // 67: inventory.v1.InventoryService.ListStockMovements:output_type -> inventory.v1.ListStockMovementsResponse
+	26, // 68: inventory.v1.InventoryService.ImportParts:output_type -> inventory.v1.ImportPartsResponse
+	29, // 69: inventory.v1.InventoryService.ExportParts:output_type -> inventory.v1.ExportPartsResponse
+	31, // 70: inventory.v1.InventoryService.CreateCompatibilityRule:output_type -> inventory.v1.CreateCompatibilityRuleResponse
+	33, // 71: inventory.v1.InventoryService.ListCompatibilityRules:output_type -> inventory.v1.ListCompatibilityRulesResponse
+	35, // 72: inventory.v1.InventoryService.DeleteCompatibilityRule:output_type -> inventory.v1.DeleteCompatibilityRuleResponse
+	37, // 73: inventory.v1.InventoryService.ValidateConfiguration:output_type -> inventory.v1.ValidateConfigurationResponse
+	62, // [62:74] is the sub-list for method output_type
+	50, // [50:62] is the sub-list for method input_type
+	50, // [50:50] is the sub-list for extension type_name
+	50, // [50:50] is the sub-list for extension extendee
+	0,  // [0:50] is the sub-list for field type_name
 }
 
 func init() { file_inventory_v1_inventory_proto_init() }
@@ -839,7 +3442,10 @@ func file_inventory_v1_inventory_proto_init() {
 	if File_inventory_v1_inventory_proto != nil {
 		return
 	}
-	file_inventory_v1_inventory_proto_msgTypes[7].OneofWrappers = []any{
+	file_inventory_v1_inventory_proto_msgTypes[8].OneofWrappers = []any{}
+	file_inventory_v1_inventory_proto_msgTypes[36].OneofWrappers = []any{}
+	file_inventory_v1_inventory_proto_msgTypes[37].OneofWrappers = []any{}
+	file_inventory_v1_inventory_proto_msgTypes[40].OneofWrappers = []any{
 		(*Value_StringValue)(nil),
 		(*Value_Int64Value)(nil),
 		(*Value_DoubleValue)(nil),
@@ -850,8 +3456,8 @@ func file_inventory_v1_inventory_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_inventory_v1_inventory_proto_rawDesc), len(file_inventory_v1_inventory_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      6,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,8 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	InventoryService_GetPart_FullMethodName   = "/inventory.v1.InventoryService/GetPart"
-	InventoryService_ListParts_FullMethodName = "/inventory.v1.InventoryService/ListParts"
+	InventoryService_GetPart_FullMethodName                 = "/inventory.v1.InventoryService/GetPart"
+	InventoryService_ListParts_FullMethodName               = "/inventory.v1.InventoryService/ListParts"
+	InventoryService_SearchParts_FullMethodName             = "/inventory.v1.InventoryService/SearchParts"
+	InventoryService_WatchParts_FullMethodName              = "/inventory.v1.InventoryService/WatchParts"
+	InventoryService_AdjustStock_FullMethodName             = "/inventory.v1.InventoryService/AdjustStock"
+	InventoryService_ListStockMovements_FullMethodName      = "/inventory.v1.InventoryService/ListStockMovements"
+	InventoryService_ImportParts_FullMethodName             = "/inventory.v1.InventoryService/ImportParts"
+	InventoryService_ExportParts_FullMethodName             = "/inventory.v1.InventoryService/ExportParts"
+	InventoryService_CreateCompatibilityRule_FullMethodName = "/inventory.v1.InventoryService/CreateCompatibilityRule"
+	InventoryService_ListCompatibilityRules_FullMethodName  = "/inventory.v1.InventoryService/ListCompatibilityRules"
+	InventoryService_DeleteCompatibilityRule_FullMethodName = "/inventory.v1.InventoryService/DeleteCompatibilityRule"
+	InventoryService_ValidateConfiguration_FullMethodName   = "/inventory.v1.InventoryService/ValidateConfiguration"
 )
 
 // InventoryServiceClient is the client API for InventoryService service.
@@ -31,8 +41,28 @@ const (
 type InventoryServiceClient interface {
 	// Возвращает информацию о детали по её UUID
 	GetPart(ctx context.Context, in *GetPartRequest, opts ...grpc.CallOption) (*GetPartResponse, error)
-	// Возвращает список деталей с возможностью фильтрации
+	// Возвращает страницу списка деталей с возможностью фильтрации и сортировки
 	ListParts(ctx context.Context, in *ListPartsRequest, opts ...grpc.CallOption) (*ListPartsResponse, error)
+	// Ищет детали по названию, описанию, тегам и производителю, наиболее подходящие - первыми
+	SearchParts(ctx context.Context, in *SearchPartsRequest, opts ...grpc.CallOption) (*SearchPartsResponse, error)
+	// Передает события создания, изменения и удаления деталей по мере их появления
+	WatchParts(ctx context.Context, in *WatchPartsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchPartsResponse], error)
+	// Изменяет количество детали на складе и записывает движение в журнал
+	AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...grpc.CallOption) (*AdjustStockResponse, error)
+	// Возвращает страницу журнала движений склада в порядке их применения
+	ListStockMovements(ctx context.Context, in *ListStockMovementsRequest, opts ...grpc.CallOption) (*ListStockMovementsResponse, error)
+	// Загружает детали пакетами и возвращает отчет по каждой детали
+	ImportParts(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportPartsRequest, ImportPartsResponse], error)
+	// Выгружает детали пакетами в порядке UUID
+	ExportParts(ctx context.Context, in *ExportPartsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportPartsResponse], error)
+	// Добавляет правило совместимости деталей
+	CreateCompatibilityRule(ctx context.Context, in *CreateCompatibilityRuleRequest, opts ...grpc.CallOption) (*CreateCompatibilityRuleResponse, error)
+	// Возвращает все правила совместимости деталей в порядке их добавления
+	ListCompatibilityRules(ctx context.Context, in *ListCompatibilityRulesRequest, opts ...grpc.CallOption) (*ListCompatibilityRulesResponse, error)
+	// Удаляет правило совместимости деталей
+	DeleteCompatibilityRule(ctx context.Context, in *DeleteCompatibilityRuleRequest, opts ...grpc.CallOption) (*DeleteCompatibilityRuleResponse, error)
+	// Проверяет, что из набора деталей можно собрать корабль, и возвращает нарушенные правила совместимости
+	ValidateConfiguration(ctx context.Context, in *ValidateConfigurationRequest, opts ...grpc.CallOption) (*ValidateConfigurationResponse, error)
 }
 
 type inventoryServiceClient struct {
@@ -63,6 +93,127 @@ func (c *inventoryServiceClient) ListParts(ctx context.Context, in *ListPartsReq
 	return out, nil
 }
 
+func (c *inventoryServiceClient) SearchParts(ctx context.Context, in *SearchPartsRequest, opts ...grpc.CallOption) (*SearchPartsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchPartsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SearchParts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) WatchParts(ctx context.Context, in *WatchPartsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchPartsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InventoryService_ServiceDesc.Streams[0], InventoryService_WatchParts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchPartsRequest, WatchPartsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_WatchPartsClient = grpc.ServerStreamingClient[WatchPartsResponse]
+
+func (c *inventoryServiceClient) AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...grpc.CallOption) (*AdjustStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdjustStockResponse)
+	err := c.cc.Invoke(ctx, InventoryService_AdjustStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListStockMovements(ctx context.Context, in *ListStockMovementsRequest, opts ...grpc.CallOption) (*ListStockMovementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStockMovementsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListStockMovements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ImportParts(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportPartsRequest, ImportPartsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InventoryService_ServiceDesc.Streams[1], InventoryService_ImportParts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportPartsRequest, ImportPartsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_ImportPartsClient = grpc.ClientStreamingClient[ImportPartsRequest, ImportPartsResponse]
+
+func (c *inventoryServiceClient) ExportParts(ctx context.Context, in *ExportPartsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportPartsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InventoryService_ServiceDesc.Streams[2], InventoryService_ExportParts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportPartsRequest, ExportPartsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_ExportPartsClient = grpc.ServerStreamingClient[ExportPartsResponse]
+
+func (c *inventoryServiceClient) CreateCompatibilityRule(ctx context.Context, in *CreateCompatibilityRuleRequest, opts ...grpc.CallOption) (*CreateCompatibilityRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCompatibilityRuleResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CreateCompatibilityRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListCompatibilityRules(ctx context.Context, in *ListCompatibilityRulesRequest, opts ...grpc.CallOption) (*ListCompatibilityRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCompatibilityRulesResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListCompatibilityRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DeleteCompatibilityRule(ctx context.Context, in *DeleteCompatibilityRuleRequest, opts ...grpc.CallOption) (*DeleteCompatibilityRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCompatibilityRuleResponse)
+	err := c.cc.Invoke(ctx, InventoryService_DeleteCompatibilityRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ValidateConfiguration(ctx context.Context, in *ValidateConfigurationRequest, opts ...grpc.CallOption) (*ValidateConfigurationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateConfigurationResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ValidateConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InventoryServiceServer is the server API for InventoryService service.
 // All implementations must embed UnimplementedInventoryServiceServer
 // for forward compatibility.
@@ -71,8 +222,28 @@ func (c *inventoryServiceClient) ListParts(ctx context.Context, in *ListPartsReq
 type InventoryServiceServer interface {
 	// Возвращает информацию о детали по её UUID
 	GetPart(context.Context, *GetPartRequest) (*GetPartResponse, error)
-	// Возвращает список деталей с возможностью фильтрации
+	// Возвращает страницу списка деталей с возможностью фильтрации и сортировки
 	ListParts(context.Context, *ListPartsRequest) (*ListPartsResponse, error)
+	// Ищет детали по названию, описанию, тегам и производителю, наиболее подходящие - первыми
+	SearchParts(context.Context, *SearchPartsRequest) (*SearchPartsResponse, error)
+	// Передает события создания, изменения и удаления деталей по мере их появления
+	WatchParts(*WatchPartsRequest, grpc.ServerStreamingServer[WatchPartsResponse]) error
+	// Изменяет количество детали на складе и записывает движение в журнал
+	AdjustStock(context.Context, *AdjustStockRequest) (*AdjustStockResponse, error)
+	// Возвращает страницу журнала движений склада в порядке их применения
+	ListStockMovements(context.Context, *ListStockMovementsRequest) (*ListStockMovementsResponse, error)
+	// Загружает детали пакетами и возвращает отчет по каждой детали
+	ImportParts(grpc.ClientStreamingServer[ImportPartsRequest, ImportPartsResponse]) error
+	// Выгружает детали пакетами в порядке UUID
+	ExportParts(*ExportPartsRequest, grpc.ServerStreamingServer[ExportPartsResponse]) error
+	// Добавляет правило совместимости деталей
+	CreateCompatibilityRule(context.Context, *CreateCompatibilityRuleRequest) (*CreateCompatibilityRuleResponse, error)
+	// Возвращает все правила совместимости деталей в порядке их добавления
+	ListCompatibilityRules(context.Context, *ListCompatibilityRulesRequest) (*ListCompatibilityRulesResponse, error)
+	// Удаляет правило совместимости деталей
+	DeleteCompatibilityRule(context.Context, *DeleteCompatibilityRuleRequest) (*DeleteCompatibilityRuleResponse, error)
+	// Проверяет, что из набора деталей можно собрать корабль, и возвращает нарушенные правила совместимости
+	ValidateConfiguration(context.Context, *ValidateConfigurationRequest) (*ValidateConfigurationResponse, error)
 	mustEmbedUnimplementedInventoryServiceServer()
 }
 
@@ -89,6 +260,36 @@ func (UnimplementedInventoryServiceServer) GetPart(context.Context, *GetPartRequ
 func (UnimplementedInventoryServiceServer) ListParts(context.Context, *ListPartsRequest) (*ListPartsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListParts not implemented")
 }
+func (UnimplementedInventoryServiceServer) SearchParts(context.Context, *SearchPartsRequest) (*SearchPartsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchParts not implemented")
+}
+func (UnimplementedInventoryServiceServer) WatchParts(*WatchPartsRequest, grpc.ServerStreamingServer[WatchPartsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchParts not implemented")
+}
+func (UnimplementedInventoryServiceServer) AdjustStock(context.Context, *AdjustStockRequest) (*AdjustStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListStockMovements(context.Context, *ListStockMovementsRequest) (*ListStockMovementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStockMovements not implemented")
+}
+func (UnimplementedInventoryServiceServer) ImportParts(grpc.ClientStreamingServer[ImportPartsRequest, ImportPartsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportParts not implemented")
+}
+func (UnimplementedInventoryServiceServer) ExportParts(*ExportPartsRequest, grpc.ServerStreamingServer[ExportPartsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportParts not implemented")
+}
+func (UnimplementedInventoryServiceServer) CreateCompatibilityRule(context.Context, *CreateCompatibilityRuleRequest) (*CreateCompatibilityRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCompatibilityRule not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListCompatibilityRules(context.Context, *ListCompatibilityRulesRequest) (*ListCompatibilityRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCompatibilityRules not implemented")
+}
+func (UnimplementedInventoryServiceServer) DeleteCompatibilityRule(context.Context, *DeleteCompatibilityRuleRequest) (*DeleteCompatibilityRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCompatibilityRule not implemented")
+}
+func (UnimplementedInventoryServiceServer) ValidateConfiguration(context.Context, *ValidateConfigurationRequest) (*ValidateConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateConfiguration not implemented")
+}
 func (UnimplementedInventoryServiceServer) mustEmbedUnimplementedInventoryServiceServer() {}
 func (UnimplementedInventoryServiceServer) testEmbeddedByValue()                          {}
 
@@ -146,6 +347,161 @@ func _InventoryService_ListParts_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_SearchParts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPartsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SearchParts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SearchParts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SearchParts(ctx, req.(*SearchPartsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_WatchParts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPartsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InventoryServiceServer).WatchParts(m, &grpc.GenericServerStream[WatchPartsRequest, WatchPartsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_WatchPartsServer = grpc.ServerStreamingServer[WatchPartsResponse]
+
+func _InventoryService_AdjustStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).AdjustStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_AdjustStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).AdjustStock(ctx, req.(*AdjustStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListStockMovements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStockMovementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListStockMovements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListStockMovements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListStockMovements(ctx, req.(*ListStockMovementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ImportParts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(InventoryServiceServer).ImportParts(&grpc.GenericServerStream[ImportPartsRequest, ImportPartsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_ImportPartsServer = grpc.ClientStreamingServer[ImportPartsRequest, ImportPartsResponse]
+
+func _InventoryService_ExportParts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportPartsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InventoryServiceServer).ExportParts(m, &grpc.GenericServerStream[ExportPartsRequest, ExportPartsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_ExportPartsServer = grpc.ServerStreamingServer[ExportPartsResponse]
+
+func _InventoryService_CreateCompatibilityRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCompatibilityRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CreateCompatibilityRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CreateCompatibilityRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CreateCompatibilityRule(ctx, req.(*CreateCompatibilityRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListCompatibilityRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCompatibilityRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListCompatibilityRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListCompatibilityRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListCompatibilityRules(ctx, req.(*ListCompatibilityRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_DeleteCompatibilityRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCompatibilityRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).DeleteCompatibilityRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_DeleteCompatibilityRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).DeleteCompatibilityRule(ctx, req.(*DeleteCompatibilityRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ValidateConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ValidateConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ValidateConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ValidateConfiguration(ctx, req.(*ValidateConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InventoryService_ServiceDesc is the grpc.ServiceDesc for InventoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -161,7 +517,51 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListParts",
 			Handler:    _InventoryService_ListParts_Handler,
 		},
+		{
+			MethodName: "SearchParts",
+			Handler:    _InventoryService_SearchParts_Handler,
+		},
+		{
+			MethodName: "AdjustStock",
+			Handler:    _InventoryService_AdjustStock_Handler,
+		},
+		{
+			MethodName: "ListStockMovements",
+			Handler:    _InventoryService_ListStockMovements_Handler,
+		},
+		{
+			MethodName: "CreateCompatibilityRule",
+			Handler:    _InventoryService_CreateCompatibilityRule_Handler,
+		},
+		{
+			MethodName: "ListCompatibilityRules",
+			Handler:    _InventoryService_ListCompatibilityRules_Handler,
+		},
+		{
+			MethodName: "DeleteCompatibilityRule",
+			Handler:    _InventoryService_DeleteCompatibilityRule_Handler,
+		},
+		{
+			MethodName: "ValidateConfiguration",
+			Handler:    _InventoryService_ValidateConfiguration_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchParts",
+			Handler:       _InventoryService_WatchParts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportParts",
+			Handler:       _InventoryService_ImportParts_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportParts",
+			Handler:       _InventoryService_ExportParts_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "inventory/v1/inventory.proto",
 }
@@ -0,0 +1,162 @@
+package tlsconfig
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+const (
+	serverEnvPrefix = "GRPC"
+
+	tlsEnabledEnvSuffix    = "_TLS_ENABLED"
+	tlsCertFileEnvSuffix   = "_TLS_CERT_FILE"
+	tlsKeyFileEnvSuffix    = "_TLS_KEY_FILE"
+	tlsCAFileEnvSuffix     = "_TLS_CA_FILE"
+	tlsClientCAEnvSuffix   = "_TLS_CLIENT_CA_FILE"
+	tlsServerNameEnvSuffix = "_TLS_SERVER_NAME"
+)
+
+// ServerConfig describes TLS of a gRPC server.
+// Client certificates are required and verified (mTLS) when client CA file is set.
+type ServerConfig interface {
+	Enabled() bool
+	CertFile() string
+	KeyFile() string
+	ClientCAFile() string
+}
+
+// ClientConfig describes TLS of a connection to a gRPC server.
+// Client certificate is presented (mTLS) when certificate and key files are set.
+type ClientConfig interface {
+	Enabled() bool
+	CAFile() string
+	CertFile() string
+	KeyFile() string
+	ServerName() string // Overrides name the server certificate is verified against
+}
+
+type serverConfig struct {
+	enabled      bool
+	certFile     string
+	keyFile      string
+	clientCAFile string
+}
+
+// NewServerEnvConfig reads server TLS settings from GRPC_TLS_* environment variables.
+// TLS is disabled unless GRPC_TLS_ENABLED is true.
+func NewServerEnvConfig() (ServerConfig, error) {
+	enabled, err := enabledFromEnv(serverEnvPrefix)
+	if err != nil || !enabled {
+		return &serverConfig{}, err
+	}
+
+	cfg := &serverConfig{
+		enabled:      true,
+		certFile:     os.Getenv(serverEnvPrefix + tlsCertFileEnvSuffix),
+		keyFile:      os.Getenv(serverEnvPrefix + tlsKeyFileEnvSuffix),
+		clientCAFile: os.Getenv(serverEnvPrefix + tlsClientCAEnvSuffix),
+	}
+	if len(cfg.certFile) == 0 || len(cfg.keyFile) == 0 {
+		return nil, fmt.Errorf("%s and %s are required when TLS is enabled",
+			serverEnvPrefix+tlsCertFileEnvSuffix, serverEnvPrefix+tlsKeyFileEnvSuffix)
+	}
+
+	return cfg, nil
+}
+
+// Enabled reports whether the server accepts TLS connections only
+func (cfg *serverConfig) Enabled() bool {
+	return cfg.enabled
+}
+
+// CertFile returns path to PEM encoded server certificate chain
+func (cfg *serverConfig) CertFile() string {
+	return cfg.certFile
+}
+
+// KeyFile returns path to PEM encoded server private key
+func (cfg *serverConfig) KeyFile() string {
+	return cfg.keyFile
+}
+
+// ClientCAFile returns path to PEM encoded CA bundle client certificates are verified with
+func (cfg *serverConfig) ClientCAFile() string {
+	return cfg.clientCAFile
+}
+
+type clientConfig struct {
+	enabled    bool
+	caFile     string
+	certFile   string
+	keyFile    string
+	serverName string
+}
+
+// NewClientEnvConfig reads TLS settings of a connection from <PREFIX>_GRPC_TLS_* environment variables.
+// TLS is disabled unless <PREFIX>_GRPC_TLS_ENABLED is true.
+func NewClientEnvConfig(prefix string) (ClientConfig, error) {
+	prefix += "_GRPC"
+
+	enabled, err := enabledFromEnv(prefix)
+	if err != nil || !enabled {
+		return &clientConfig{}, err
+	}
+
+	cfg := &clientConfig{
+		enabled:    true,
+		caFile:     os.Getenv(prefix + tlsCAFileEnvSuffix),
+		certFile:   os.Getenv(prefix + tlsCertFileEnvSuffix),
+		keyFile:    os.Getenv(prefix + tlsKeyFileEnvSuffix),
+		serverName: os.Getenv(prefix + tlsServerNameEnvSuffix),
+	}
+	if len(cfg.caFile) == 0 {
+		return nil, fmt.Errorf("%s is required when TLS is enabled", prefix+tlsCAFileEnvSuffix)
+	}
+	if (len(cfg.certFile) == 0) != (len(cfg.keyFile) == 0) {
+		return nil, fmt.Errorf("%s and %s must be set together",
+			prefix+tlsCertFileEnvSuffix, prefix+tlsKeyFileEnvSuffix)
+	}
+
+	return cfg, nil
+}
+
+// Enabled reports whether the connection uses TLS
+func (cfg *clientConfig) Enabled() bool {
+	return cfg.enabled
+}
+
+// CAFile returns path to PEM encoded CA bundle the server certificate is verified with
+func (cfg *clientConfig) CAFile() string {
+	return cfg.caFile
+}
+
+// CertFile returns path to PEM encoded client certificate chain
+func (cfg *clientConfig) CertFile() string {
+	return cfg.certFile
+}
+
+// KeyFile returns path to PEM encoded client private key
+func (cfg *clientConfig) KeyFile() string {
+	return cfg.keyFile
+}
+
+// ServerName returns name the server certificate is verified against
+func (cfg *clientConfig) ServerName() string {
+	return cfg.serverName
+}
+
+// enabledFromEnv parses <PREFIX>_TLS_ENABLED, missing variable means disabled
+func enabledFromEnv(prefix string) (bool, error) {
+	raw := os.Getenv(prefix + tlsEnabledEnvSuffix)
+	if len(raw) == 0 {
+		return false, nil
+	}
+
+	enabled, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse %s: %w", prefix+tlsEnabledEnvSuffix, err)
+	}
+
+	return enabled, nil
+}
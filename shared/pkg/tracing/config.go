@@ -0,0 +1,95 @@
+package tracing
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+const (
+	exporterEnvName     = "TRACING_EXPORTER"
+	otlpEndpointEnvName = "TRACING_OTLP_ENDPOINT"
+	sampleRatioEnvName  = "TRACING_SAMPLE_RATIO"
+)
+
+// Exporter kinds supported by NewExporter
+const (
+	ExporterNone   = "none"   // Spans are not recorded, only context is propagated
+	ExporterStdout = "stdout" // Spans are printed to stdout, for local runs
+	ExporterOTLP   = "otlp"   // Spans are sent to OTLP gRPC collector
+)
+
+// Config describes how spans of a service are sampled and exported
+type Config interface {
+	ServiceName() string
+	Exporter() string
+	OTLPEndpoint() string
+	SampleRatio() float64
+}
+
+type config struct {
+	serviceName  string
+	exporter     string
+	otlpEndpoint string
+	sampleRatio  float64
+}
+
+// NewEnvConfig reads tracing configuration of the named service from environment variables.
+// Tracing is disabled unless TRACING_EXPORTER is set, all traces are sampled by default.
+func NewEnvConfig(serviceName string) (Config, error) {
+	exporter := os.Getenv(exporterEnvName)
+	if len(exporter) == 0 {
+		exporter = ExporterNone
+	}
+
+	otlpEndpoint := os.Getenv(otlpEndpointEnvName)
+
+	switch exporter {
+	case ExporterNone, ExporterStdout:
+	case ExporterOTLP:
+		if len(otlpEndpoint) == 0 {
+			return nil, fmt.Errorf("%s is required for %s exporter", otlpEndpointEnvName, ExporterOTLP)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported tracing exporter: %s", exporter)
+	}
+
+	sampleRatio := 1.0
+	if raw := os.Getenv(sampleRatioEnvName); len(raw) != 0 {
+		ratio, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", sampleRatioEnvName, err)
+		}
+		if ratio < 0 || ratio > 1 {
+			return nil, fmt.Errorf("%s must be within [0, 1], got %v", sampleRatioEnvName, ratio)
+		}
+		sampleRatio = ratio
+	}
+
+	return &config{
+		serviceName:  serviceName,
+		exporter:     exporter,
+		otlpEndpoint: otlpEndpoint,
+		sampleRatio:  sampleRatio,
+	}, nil
+}
+
+// ServiceName returns name reported as service.name resource attribute
+func (cfg *config) ServiceName() string {
+	return cfg.serviceName
+}
+
+// Exporter returns exporter kind
+func (cfg *config) Exporter() string {
+	return cfg.exporter
+}
+
+// OTLPEndpoint returns host:port of OTLP gRPC collector
+func (cfg *config) OTLPEndpoint() string {
+	return cfg.otlpEndpoint
+}
+
+// SampleRatio returns fraction of root traces that are recorded
+func (cfg *config) SampleRatio() float64 {
+	return cfg.sampleRatio
+}
@@ -0,0 +1,48 @@
+package tracing
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc/filters"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"google.golang.org/grpc"
+)
+
+// probePaths are not traced, they would only add noise
+var probePaths = map[string]struct{}{
+	"/healthz": {},
+	"/readyz":  {},
+	"/metrics": {},
+}
+
+// ServerOption instruments gRPC server: spans are started for incoming RPCs
+// and parented by the trace context received in metadata
+func ServerOption() grpc.ServerOption {
+	return grpc.StatsHandler(otelgrpc.NewServerHandler(
+		otelgrpc.WithFilter(filters.Not(filters.HealthCheck())),
+	))
+}
+
+// DialOption instruments gRPC client: spans are started for outgoing RPCs
+// and trace context is injected into metadata
+func DialOption() grpc.DialOption {
+	return grpc.WithStatsHandler(otelgrpc.NewClientHandler(
+		otelgrpc.WithFilter(filters.Not(filters.HealthCheck())),
+	))
+}
+
+// HTTPMiddleware starts a span for every incoming HTTP request
+// and extracts trace context from request headers
+func HTTPMiddleware(serviceName string) func(http.Handler) http.Handler {
+	return otelhttp.NewMiddleware(serviceName,
+		otelhttp.WithFilter(func(r *http.Request) bool {
+			_, isProbe := probePaths[r.URL.Path]
+			return !isProbe
+		}),
+		// Route templates are unknown before routing, raw paths would blow up span name cardinality
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return r.Method
+		}),
+	)
+}